@@ -2,8 +2,10 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -41,6 +43,41 @@ func TestAccAwsImageBuilderComponentDataSource_Arn(t *testing.T) {
 	})
 }
 
+func TestAccAwsImageBuilderComponentDataSource_AwsManaged(t *testing.T) {
+	dataSourceName := "data.aws_imagebuilder_component.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderComponentDataSourceConfigAwsManaged(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccMatchResourceAttrRegionalARNAccountID(dataSourceName, "arn", "imagebuilder", "aws", regexp.MustCompile(`component/update-linux/1\.0\.0/1`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "data"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "date_created"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "update-linux"),
+					resource.TestCheckResourceAttr(dataSourceName, "owner", "Amazon"),
+					resource.TestCheckResourceAttr(dataSourceName, "platform", imagebuilder.PlatformLinux),
+					resource.TestCheckResourceAttr(dataSourceName, "type", imagebuilder.ComponentTypeBuild),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsImageBuilderComponentDataSourceConfigAwsManaged() string {
+	return `
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_imagebuilder_component" "test" {
+  arn = "arn:${data.aws_partition.current.partition}:imagebuilder:${data.aws_region.current.name}:aws:component/update-linux/1.0.0/1"
+}
+`
+}
+
 func testAccAwsImageBuilderComponentDataSourceConfigBuildVersionArn(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_imagebuilder_component" "test" {
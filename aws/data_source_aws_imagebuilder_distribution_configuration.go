@@ -104,7 +104,16 @@ func datasourceAwsImageBuilderDistributionConfiguration() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"region_to_ami_name": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"tags": tagsSchemaComputed(),
+			"target_account_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -136,9 +145,12 @@ func datasourceAwsImageBuilderDistributionConfigurationRead(d *schema.ResourceDa
 	d.Set("date_created", distributionConfiguration.DateCreated)
 	d.Set("date_updated", distributionConfiguration.DateUpdated)
 	d.Set("description", distributionConfiguration.Description)
-	d.Set("distribution", flattenImageBuilderDistributions(distributionConfiguration.Distributions))
+	distributions := flattenImageBuilderDistributions(distributionConfiguration.Distributions)
+	d.Set("distribution", distributions)
 	d.Set("name", distributionConfiguration.Name)
+	d.Set("region_to_ami_name", imageBuilderDistributionsRegionToAmiName(distributions))
 	d.Set("tags", keyvaluetags.ImagebuilderKeyValueTags(distributionConfiguration.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map())
+	d.Set("target_account_count", imageBuilderDistributionsTargetAccountCount(distributions))
 
 	return nil
 }
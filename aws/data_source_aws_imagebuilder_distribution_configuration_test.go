@@ -27,7 +27,9 @@ func TestAccAwsImageBuilderDistributionConfigurationDataSource_Arn(t *testing.T)
 					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "distribution.#", resourceName, "distribution.#"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "region_to_ami_name.%", resourceName, "region_to_ami_name.%"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_account_count", resourceName, "target_account_count"),
 				),
 			},
 		},
@@ -100,6 +100,43 @@ func dataSourceAwsImageBuilderImage() *schema.Resource {
 								},
 							},
 						},
+						"containers": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"image_uris": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"images": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"repository": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"tag": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"uri": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -107,6 +144,10 @@ func dataSourceAwsImageBuilderImage() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"source_pipeline_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tags": tagsSchemaComputed(),
 			"version": {
 				Type:     schema.TypeString,
@@ -179,6 +220,7 @@ func dataSourceAwsImageBuilderImageRead(d *schema.ResourceData, meta interface{}
 		d.Set("output_resources", nil)
 	}
 
+	d.Set("source_pipeline_arn", image.SourcePipelineArn)
 	d.Set("tags", keyvaluetags.ImagebuilderKeyValueTags(image.Tags).IgnoreAws().IgnoreConfig(meta.(*AWSClient).IgnoreTagsConfig).Map())
 	d.Set("version", image.Version)
 
@@ -144,7 +144,7 @@ func dataSourceAwsImageBuilderImagePipelineRead(d *schema.ResourceData, meta int
 
 	d.Set("infrastructure_configuration_arn", imagePipeline.InfrastructureConfigurationArn)
 	d.Set("name", imagePipeline.Name)
-	d.Set("platform", imagePipeline.Platform)
+	d.Set("platform", imageBuilderImagePipelinePlatform(conn, imagePipeline))
 
 	if imagePipeline.Schedule != nil {
 		d.Set("schedule", []interface{}{flattenImageBuilderSchedule(imagePipeline.Schedule)})
@@ -84,9 +84,17 @@ func dataSourceAwsImageBuilderImageRecipe() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
+			"component_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -95,6 +103,10 @@ func dataSourceAwsImageBuilderImageRecipe() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -150,8 +162,10 @@ func dataSourceAwsImageBuilderImageRecipeRead(d *schema.ResourceData, meta inter
 	d.Set("arn", imageRecipe.Arn)
 	d.Set("block_device_mapping", flattenImageBuilderInstanceBlockDeviceMappings(imageRecipe.BlockDeviceMappings))
 	d.Set("component", flattenImageBuilderComponentConfigurations(imageRecipe.Components))
+	d.Set("component_count", len(imageRecipe.Components))
 	d.Set("date_created", imageRecipe.DateCreated)
 	d.Set("description", imageRecipe.Description)
+	d.Set("encrypted", imageBuilderInstanceBlockDeviceMappingsEncrypted(imageRecipe.BlockDeviceMappings))
 	d.Set("name", imageRecipe.Name)
 	d.Set("owner", imageRecipe.Owner)
 	d.Set("parent_image", imageRecipe.ParentImage)
@@ -66,7 +66,10 @@ func TestAccAwsImageBuilderImageDataSource_Arn_Self(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "os_version", resourceName, "os_version"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "output_resources.#", resourceName, "output_resources.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "output_resources.0.amis.#", resourceName, "output_resources.0.amis.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "output_resources.0.containers.#", resourceName, "output_resources.0.containers.#"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "platform", resourceName, "platform"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "source_pipeline_arn", resourceName, "source_pipeline_arn"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "version", resourceName, "version"),
 				),
@@ -0,0 +1,313 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	tfimagebuilder "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/imagebuilder"
+)
+
+func dataSourceAwsImageBuilderImages() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAwsImageBuilderImagesRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"name", "osVersion", "platform", "type", "version"}, false),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"images": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"os_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"output_resources": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"amis": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"account_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"description": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"image": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"region": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"containers": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"image_uris": {
+													Type:     schema.TypeSet,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"images": {
+													Type:     schema.TypeSet,
+													Computed: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"repository": {
+																Type:     schema.TypeString,
+																Computed: true,
+															},
+															"tag": {
+																Type:     schema.TypeString,
+																Computed: true,
+															},
+															"uri": {
+																Type:     schema.TypeString,
+																Computed: true,
+															},
+														},
+													},
+												},
+												"region": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"platform": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(imagebuilder.Ownership_Values(), false),
+			},
+			"resolve_output_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsImageBuilderImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	input := &imagebuilder.ListImagesInput{}
+
+	if v, ok := d.GetOk("filter"); ok && v.(*schema.Set).Len() > 0 {
+		input.Filters = expandImageBuilderFilters(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("owner"); ok {
+		input.Owner = aws.String(v.(string))
+	}
+
+	var imageVersions []*imagebuilder.ImageVersion
+
+	err := conn.ListImagesPagesWithContext(ctx, input, func(page *imagebuilder.ListImagesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, imageVersion := range page.ImageVersionList {
+			if imageVersion == nil {
+				continue
+			}
+
+			imageVersions = append(imageVersions, imageVersion)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Image Builder Images: %w", err))
+	}
+
+	// Sort by ARN so that the results do not change order between reads.
+	sort.Slice(imageVersions, func(i, j int) bool {
+		return aws.StringValue(imageVersions[i].Arn) < aws.StringValue(imageVersions[j].Arn)
+	})
+
+	arns := make([]string, 0, len(imageVersions))
+
+	for _, imageVersion := range imageVersions {
+		arns = append(arns, aws.StringValue(imageVersion.Arn))
+	}
+
+	images := flattenImageBuilderImageVersions(imageVersions)
+
+	// Output resources are only available per image, so reading them requires a GetImage call for each image version.
+	// ListImages returns semantic version ARNs, for which GetImage returns the latest build only.
+	if d.Get("resolve_output_resources").(bool) && len(arns) > 0 {
+		imagesByArn, err := tfimagebuilder.ImagesByARNs(ctx, conn, arns, tfimagebuilder.DefaultImagesReadConcurrency)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading Image Builder Images output resources: %w", err))
+		}
+
+		for i, arn := range arns {
+			if image, ok := imagesByArn[arn]; ok && image.OutputResources != nil {
+				images[i].(map[string]interface{})["output_resources"] = []interface{}{flattenImageBuilderOutputResources(image.OutputResources)}
+			}
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(input.String())))
+
+	if err := d.Set("arns", arns); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting arns: %w", err))
+	}
+
+	if err := d.Set("images", images); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting images: %w", err))
+	}
+
+	return nil
+}
+
+func expandImageBuilderFilters(tfList []interface{}) []*imagebuilder.Filter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*imagebuilder.Filter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &imagebuilder.Filter{}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Values = expandStringSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenImageBuilderImageVersion(apiObject *imagebuilder.ImageVersion) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Arn; v != nil {
+		tfMap["arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.OsVersion; v != nil {
+		tfMap["os_version"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Platform; v != nil {
+		tfMap["platform"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Version; v != nil {
+		tfMap["version"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenImageBuilderImageVersions(apiObjects []*imagebuilder.ImageVersion) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenImageBuilderImageVersion(apiObject))
+	}
+
+	return tfList
+}
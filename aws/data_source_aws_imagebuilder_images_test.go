@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAwsImageBuilderImagesDataSource_Filter(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_imagebuilder_images.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImagesDataSourceConfigFilter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "images.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderImagesDataSource_Filter_Name(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_imagebuilder_images.test"
+	resourceName := "aws_imagebuilder_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImagesDataSourceConfigFilterName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "images.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.arn", dataSourceName, "arns.0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.platform", resourceName, "platform"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderImagesDataSource_Owner_Amazon(t *testing.T) {
+	dataSourceName := "data.aws_imagebuilder_images.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImagesDataSourceConfigOwnerAmazon(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "arns.#", regexp.MustCompile(`^[1-9][0-9]*$`)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.arn", dataSourceName, "arns.0"),
+					testAccMatchResourceAttrRegionalARNAccountID(dataSourceName, "images.0.arn", "imagebuilder", "aws", regexp.MustCompile(`image/amazon-linux-2-x86/\d+\.\d+\.\d+`)),
+					resource.TestCheckResourceAttr(dataSourceName, "images.0.name", "Amazon Linux 2 x86"),
+					resource.TestCheckResourceAttr(dataSourceName, "images.0.os_version", "Amazon Linux 2"),
+					resource.TestCheckResourceAttr(dataSourceName, "images.0.platform", imagebuilder.PlatformLinux),
+					resource.TestMatchResourceAttr(dataSourceName, "images.0.version", regexp.MustCompile(`^\d+\.\d+\.\d+$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderImagesDataSource_ResolveOutputResources(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_imagebuilder_images.test"
+	resourceName := "aws_imagebuilder_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImagesDataSourceConfigResolveOutputResources(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "images.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "images.0.output_resources.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.output_resources.0.amis.#", resourceName, "output_resources.0.amis.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsImageBuilderImagesDataSourceConfigFilter(rName string) string {
+	return fmt.Sprintf(`
+data "aws_imagebuilder_images" "test" {
+  filter {
+    name   = "name"
+    values = [%[1]q]
+  }
+}
+`, rName)
+}
+
+func testAccAwsImageBuilderImagesDataSourceConfigFilterName(rName string) string {
+	return composeConfig(
+		testAccAwsImageBuilderImageConfigRequired(rName),
+		`
+data "aws_imagebuilder_images" "test" {
+  filter {
+    name   = "name"
+    values = [aws_imagebuilder_image.test.name]
+  }
+}
+`)
+}
+
+func testAccAwsImageBuilderImagesDataSourceConfigOwnerAmazon() string {
+	return `
+data "aws_imagebuilder_images" "test" {
+  owner = "Amazon"
+
+  filter {
+    name   = "name"
+    values = ["Amazon Linux 2 x86"]
+  }
+
+  filter {
+    name   = "platform"
+    values = ["Linux"]
+  }
+}
+`
+}
+
+func testAccAwsImageBuilderImagesDataSourceConfigResolveOutputResources(rName string) string {
+	return composeConfig(
+		testAccAwsImageBuilderImageConfigRequired(rName),
+		`
+data "aws_imagebuilder_images" "test" {
+  resolve_output_resources = true
+
+  filter {
+    name   = "name"
+    values = [aws_imagebuilder_image.test.name]
+  }
+}
+`)
+}
@@ -0,0 +1,155 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfimagebuilder "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/imagebuilder"
+)
+
+func dataSourceAwsImageBuilderNextRecipeVersion() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsImageBuilderNextRecipeVersionRead,
+
+		Schema: map[string]*schema.Schema{
+			"latest_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 126),
+			},
+			"next_minor_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"next_patch_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      imagebuilder.OwnershipSelf,
+				ValidateFunc: validation.StringInSlice(imagebuilder.Ownership_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceAwsImageBuilderNextRecipeVersionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	name := d.Get("name").(string)
+	owner := d.Get("owner").(string)
+	filters := []*imagebuilder.Filter{
+		{
+			Name:   aws.String("name"),
+			Values: aws.StringSlice([]string{name}),
+		},
+	}
+
+	var arns []string
+
+	imageRecipesInput := &imagebuilder.ListImageRecipesInput{
+		Filters: filters,
+		Owner:   aws.String(owner),
+	}
+
+	err := conn.ListImageRecipesPages(imageRecipesInput, func(page *imagebuilder.ListImageRecipesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, imageRecipeSummary := range page.ImageRecipeSummaryList {
+			if imageRecipeSummary == nil || aws.StringValue(imageRecipeSummary.Name) != name {
+				continue
+			}
+
+			arns = append(arns, aws.StringValue(imageRecipeSummary.Arn))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Image Builder Image Recipes: %w", err)
+	}
+
+	containerRecipesInput := &imagebuilder.ListContainerRecipesInput{
+		Filters: filters,
+		Owner:   aws.String(owner),
+	}
+
+	err = conn.ListContainerRecipesPages(containerRecipesInput, func(page *imagebuilder.ListContainerRecipesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, containerRecipeSummary := range page.ContainerRecipeSummaryList {
+			if containerRecipeSummary == nil || aws.StringValue(containerRecipeSummary.Name) != name {
+				continue
+			}
+
+			arns = append(arns, aws.StringValue(containerRecipeSummary.Arn))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Image Builder Container Recipes: %w", err)
+	}
+
+	var latestVersion string
+
+	for _, arn := range arns {
+		version, err := tfimagebuilder.RecipeARNToSemanticVersion(arn)
+
+		if err != nil {
+			return fmt.Errorf("error parsing Image Builder Recipe version: %w", err)
+		}
+
+		if latestVersion == "" {
+			latestVersion = version
+			continue
+		}
+
+		result, err := tfimagebuilder.SemanticVersionCompare(version, latestVersion)
+
+		if err != nil {
+			return fmt.Errorf("error comparing Image Builder Recipe (%s) version: %w", arn, err)
+		}
+
+		if result > 0 {
+			latestVersion = version
+		}
+	}
+
+	nextPatchVersion := tfimagebuilder.SemanticVersionInitial
+	nextMinorVersion := tfimagebuilder.SemanticVersionInitial
+
+	if latestVersion != "" {
+		if nextPatchVersion, err = tfimagebuilder.SemanticVersionNextPatch(latestVersion); err != nil {
+			return fmt.Errorf("error determining next Image Builder Recipe (%s) patch version: %w", name, err)
+		}
+
+		if nextMinorVersion, err = tfimagebuilder.SemanticVersionNextMinor(latestVersion); err != nil {
+			return fmt.Errorf("error determining next Image Builder Recipe (%s) minor version: %w", name, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, name))
+	d.Set("latest_version", latestVersion)
+	d.Set("name", name)
+	d.Set("next_minor_version", nextMinorVersion)
+	d.Set("next_patch_version", nextPatchVersion)
+	d.Set("owner", owner)
+
+	return nil
+}
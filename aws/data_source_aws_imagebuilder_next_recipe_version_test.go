@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAwsImageBuilderNextRecipeVersionDataSource_Name(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_imagebuilder_next_recipe_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsImageBuilderImageRecipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderNextRecipeVersionDataSourceConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "latest_version", "1.10.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "next_minor_version", "1.11.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "next_patch_version", "1.10.1"),
+					resource.TestCheckResourceAttr(dataSourceName, "owner", imagebuilder.OwnershipSelf),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderNextRecipeVersionDataSource_NoRecipes(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_imagebuilder_next_recipe_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderNextRecipeVersionDataSourceConfigNoRecipes(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "latest_version", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "next_minor_version", "1.0.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "next_patch_version", "1.0.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsImageBuilderNextRecipeVersionDataSourceConfigName(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_imagebuilder_component" "test" {
+  data = yamlencode({
+    phases = [{
+      name = "build"
+      steps = [{
+        action = "ExecuteBash"
+        inputs = {
+          commands = ["echo 'hello world'"]
+        }
+        name      = "example"
+        onFailure = "Continue"
+      }]
+    }]
+    schemaVersion = 1.0
+  })
+  name     = %[1]q
+  platform = "Linux"
+  version  = "1.0.0"
+}
+
+resource "aws_imagebuilder_image_recipe" "test" {
+  for_each = toset(["1.9.0", "1.10.0"])
+
+  component {
+    component_arn = aws_imagebuilder_component.test.arn
+  }
+
+  name         = %[1]q
+  parent_image = "arn:${data.aws_partition.current.partition}:imagebuilder:${data.aws_region.current.name}:aws:image/amazon-linux-2-x86/x.x.x"
+  version      = each.value
+}
+
+data "aws_imagebuilder_next_recipe_version" "test" {
+  name = %[1]q
+
+  depends_on = [aws_imagebuilder_image_recipe.test]
+}
+`, rName)
+}
+
+func testAccAwsImageBuilderNextRecipeVersionDataSourceConfigNoRecipes(rName string) string {
+	return fmt.Sprintf(`
+data "aws_imagebuilder_next_recipe_version" "test" {
+  name = %[1]q
+}
+`, rName)
+}
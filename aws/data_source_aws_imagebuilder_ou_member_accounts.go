@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAwsImageBuilderOuMemberAccounts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsImageBuilderOuMemberAccountsRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsImageBuilderOuMemberAccountsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	parentID := d.Get("parent_id").(string)
+
+	input := &organizations.ListAccountsForParentInput{
+		ParentId: aws.String(parentID),
+	}
+
+	var accountIDs []string
+
+	err := conn.ListAccountsForParentPages(input, func(page *organizations.ListAccountsForParentOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, account := range page.Accounts {
+			if account == nil {
+				continue
+			}
+
+			accountIDs = append(accountIDs, aws.StringValue(account.Id))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Organizations Accounts for parent (%s): %w", parentID, err)
+	}
+
+	d.SetId(parentID)
+
+	if err := d.Set("account_ids", accountIDs); err != nil {
+		return fmt.Errorf("error setting account_ids: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccAwsImageBuilderOuMemberAccountsDataSource_ParentId(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_imagebuilder_ou_member_accounts.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOrganizationsEnabledPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderOuMemberAccountsDataSourceConfigParentId(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "account_ids.#", "0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "parent_id", "aws_organizations_organizational_unit.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsImageBuilderOuMemberAccountsDataSourceConfigParentId(rName string) string {
+	return fmt.Sprintf(`
+data "aws_organizations_organization" "test" {}
+
+resource "aws_organizations_organizational_unit" "test" {
+  name      = %[1]q
+  parent_id = data.aws_organizations_organization.test.roots[0].id
+}
+
+data "aws_imagebuilder_ou_member_accounts" "test" {
+  parent_id = aws_organizations_organizational_unit.test.id
+}
+`, rName)
+}
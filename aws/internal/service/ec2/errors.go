@@ -12,6 +12,11 @@ const (
 	ErrCodeInvalidParameterValue = "InvalidParameterValue"
 )
 
+const (
+	ErrCodeInvalidAMIIDNotFound    = "InvalidAMIID.NotFound"
+	ErrCodeInvalidAMIIDUnavailable = "InvalidAMIID.Unavailable"
+)
+
 const (
 	ErrCodeInvalidCarrierGatewayIDNotFound = "InvalidCarrierGatewayID.NotFound"
 )
@@ -35,11 +40,19 @@ const (
 	ErrCodeInvalidInstanceIDNotFound = "InvalidInstanceID.NotFound"
 )
 
+const (
+	ErrCodeInvalidKeyPairNotFound = "InvalidKeyPair.NotFound"
+)
+
 const (
 	InvalidSecurityGroupIDNotFound = "InvalidSecurityGroupID.NotFound"
 	InvalidGroupNotFound           = "InvalidGroup.NotFound"
 )
 
+const (
+	ErrCodeInvalidSnapshotNotFound = "InvalidSnapshot.NotFound"
+)
+
 const (
 	ErrCodeInvalidSubnetIDNotFound = "InvalidSubnetID.NotFound"
 )
@@ -76,6 +76,25 @@ func ClientVpnRouteByID(conn *ec2.EC2, routeID string) (*ec2.DescribeClientVpnRo
 	return ClientVpnRoute(conn, endpointID, targetSubnetID, destinationCidr)
 }
 
+// ImageByID looks up an Image by ID. When not found, returns nil and potentially an API error.
+func ImageByID(conn *ec2.EC2, id string) (*ec2.Image, error) {
+	input := &ec2.DescribeImagesInput{
+		ImageIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeImages(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Images) == 0 || output.Images[0] == nil {
+		return nil, nil
+	}
+
+	return output.Images[0], nil
+}
+
 // InstanceByID looks up a Instance by ID. When not found, returns nil and potentially an API error.
 func InstanceByID(conn *ec2.EC2, id string) (*ec2.Instance, error) {
 	input := &ec2.DescribeInstancesInput{
@@ -95,6 +114,70 @@ func InstanceByID(conn *ec2.EC2, id string) (*ec2.Instance, error) {
 	return output.Reservations[0].Instances[0], nil
 }
 
+// KeyPairByName looks up a Key Pair by name. When not found, returns nil and potentially an API error.
+func KeyPairByName(conn *ec2.EC2, name string) (*ec2.KeyPairInfo, error) {
+	input := &ec2.DescribeKeyPairsInput{
+		KeyNames: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeKeyPairs(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.KeyPairs) == 0 || output.KeyPairs[0] == nil {
+		return nil, nil
+	}
+
+	return output.KeyPairs[0], nil
+}
+
+// MainRouteTableByVpcID returns the main route table of the specified VPC.
+// Returns nil and potentially an API error if no main route table is found.
+func MainRouteTableByVpcID(conn *ec2.EC2, vpcID string) (*ec2.RouteTable, error) {
+	input := &ec2.DescribeRouteTablesInput{
+		Filters: tfec2.BuildAttributeFilterList(map[string]string{
+			"association.main": "true",
+			"vpc-id":           vpcID,
+		}),
+	}
+
+	output, err := conn.DescribeRouteTables(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.RouteTables) == 0 || output.RouteTables[0] == nil {
+		return nil, nil
+	}
+
+	return output.RouteTables[0], nil
+}
+
+// RouteTableBySubnetID returns the route table explicitly associated with the specified subnet.
+// Returns nil and potentially an API error if no route table is explicitly associated.
+func RouteTableBySubnetID(conn *ec2.EC2, subnetID string) (*ec2.RouteTable, error) {
+	input := &ec2.DescribeRouteTablesInput{
+		Filters: tfec2.BuildAttributeFilterList(map[string]string{
+			"association.subnet-id": subnetID,
+		}),
+	}
+
+	output, err := conn.DescribeRouteTables(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.RouteTables) == 0 || output.RouteTables[0] == nil {
+		return nil, nil
+	}
+
+	return output.RouteTables[0], nil
+}
+
 // SecurityGroupByID looks up a security group by ID. When not found, returns nil and potentially an API error.
 func SecurityGroupByID(conn *ec2.EC2, id string) (*ec2.SecurityGroup, error) {
 	req := &ec2.DescribeSecurityGroupsInput{
@@ -112,6 +195,25 @@ func SecurityGroupByID(conn *ec2.EC2, id string) (*ec2.SecurityGroup, error) {
 	return result.SecurityGroups[0], nil
 }
 
+// SnapshotByID looks up an EBS Snapshot by ID. When not found, returns nil and potentially an API error.
+func SnapshotByID(conn *ec2.EC2, id string) (*ec2.Snapshot, error) {
+	input := &ec2.DescribeSnapshotsInput{
+		SnapshotIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeSnapshots(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Snapshots) == 0 || output.Snapshots[0] == nil {
+		return nil, nil
+	}
+
+	return output.Snapshots[0], nil
+}
+
 // SubnetByID looks up a Subnet by ID. When not found, returns nil and potentially an API error.
 func SubnetByID(conn *ec2.EC2, id string) (*ec2.Subnet, error) {
 	input := &ec2.DescribeSubnetsInput{
@@ -0,0 +1,26 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+// InstanceProfileByName returns the instance profile corresponding to the specified name.
+// Returns nil and potentially an API error if no instance profile is found.
+func InstanceProfileByName(conn *iam.IAM, name string) (*iam.InstanceProfile, error) {
+	input := &iam.GetInstanceProfileInput{
+		InstanceProfileName: aws.String(name),
+	}
+
+	output, err := conn.GetInstanceProfile(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.InstanceProfile, nil
+}
@@ -0,0 +1,157 @@
+package imagebuilder
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+const (
+	ARNSeparator = "/"
+
+	// ARNAccountIDAws is the account identifier of Amazon-managed resources.
+	ARNAccountIDAws = "aws"
+
+	ComponentResourcePrefix       = "component"
+	ContainerRecipeResourcePrefix = "container-recipe"
+	ImageRecipeResourcePrefix     = "image-recipe"
+	ImageResourcePrefix           = "image"
+)
+
+// ARNsPartitionAndAccountCheck verifies that all Amazon Resource Names (ARNs) are in the same partition.
+// Empty ARNs are skipped. It additionally reports whether the ARNs reference more than one account,
+// ignoring Amazon-managed resources, which is legitimate when resources are shared.
+func ARNsPartitionAndAccountCheck(inputARNs ...string) (bool, error) {
+	var partition, accountID string
+	var accountMismatch bool
+
+	for _, inputARN := range inputARNs {
+		if inputARN == "" {
+			continue
+		}
+
+		parsedARN, err := arn.Parse(inputARN)
+
+		if err != nil {
+			return false, fmt.Errorf("error parsing ARN (%s): %w", inputARN, err)
+		}
+
+		if partition == "" {
+			partition = parsedARN.Partition
+		} else if actual, expected := parsedARN.Partition, partition; actual != expected {
+			return false, fmt.Errorf("expected partition %s in ARN (%s), got: %s", expected, inputARN, actual)
+		}
+
+		if parsedARN.AccountID == ARNAccountIDAws {
+			continue
+		}
+
+		if accountID == "" {
+			accountID = parsedARN.AccountID
+		} else if parsedARN.AccountID != accountID {
+			accountMismatch = true
+		}
+	}
+
+	return accountMismatch, nil
+}
+
+// ImageARNToRegion returns the region in which an Image Builder image was built from its
+// Amazon Resource Name (ARN), e.g. arn:aws:imagebuilder:us-east-1:123456789012:image/example/1.0.0/1.
+func ImageARNToRegion(inputARN string) (string, error) {
+	parsedARN, err := arn.Parse(inputARN)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing ARN (%s): %w", inputARN, err)
+	}
+
+	resourceParts := strings.Split(parsedARN.Resource, ARNSeparator)
+
+	if actual, expected := resourceParts[0], ImageResourcePrefix; actual != expected {
+		return "", fmt.Errorf("expected resource prefix %s in ARN (%s), got: %s", expected, inputARN, actual)
+	}
+
+	if parsedARN.Region == "" {
+		return "", fmt.Errorf("expected region in ARN (%s)", inputARN)
+	}
+
+	return parsedARN.Region, nil
+}
+
+// RecipeARNToSemanticVersion returns the semantic version of an Image Builder image or container recipe
+// Amazon Resource Name (ARN), e.g. arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/example/1.0.0.
+func RecipeARNToSemanticVersion(inputARN string) (string, error) {
+	parsedARN, err := arn.Parse(inputARN)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing ARN (%s): %w", inputARN, err)
+	}
+
+	resourceParts := strings.Split(parsedARN.Resource, ARNSeparator)
+
+	if actual, expected := len(resourceParts), 3; actual != expected {
+		return "", fmt.Errorf("expected %d resource parts in ARN (%s), got: %d", expected, inputARN, actual)
+	}
+
+	if actual := resourceParts[0]; actual != ImageRecipeResourcePrefix && actual != ContainerRecipeResourcePrefix {
+		return "", fmt.Errorf("expected resource prefix %s or %s in ARN (%s), got: %s", ImageRecipeResourcePrefix, ContainerRecipeResourcePrefix, inputARN, actual)
+	}
+
+	return resourceParts[2], nil
+}
+
+// RecipeARNToUnversionedARN returns an Image Builder image or container recipe Amazon Resource Name (ARN)
+// without its version, e.g. arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/example
+// for arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/example/1.0.0.
+func RecipeARNToUnversionedARN(inputARN string) (string, error) {
+	parsedARN, err := arn.Parse(inputARN)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing ARN (%s): %w", inputARN, err)
+	}
+
+	resourceParts := strings.Split(parsedARN.Resource, ARNSeparator)
+
+	if actual, expected := len(resourceParts), 3; actual != expected {
+		return "", fmt.Errorf("expected %d resource parts in ARN (%s), got: %d", expected, inputARN, actual)
+	}
+
+	if actual := resourceParts[0]; actual != ImageRecipeResourcePrefix && actual != ContainerRecipeResourcePrefix {
+		return "", fmt.Errorf("expected resource prefix %s or %s in ARN (%s), got: %s", ImageRecipeResourcePrefix, ContainerRecipeResourcePrefix, inputARN, actual)
+	}
+
+	parsedARN.Resource = strings.Join(resourceParts[:2], ARNSeparator)
+
+	return parsedARN.String(), nil
+}
+
+// ComponentARNToSemanticVersion returns the semantic version of an Image Builder component
+// Amazon Resource Name (ARN), e.g. arn:aws:imagebuilder:us-east-1:aws:component/update-linux/1.0.0
+// or arn:aws:imagebuilder:us-east-1:aws:component/update-linux/1.0.0/1.
+// An empty version is returned for ARNs with version wildcards, e.g. component/update-linux/x.x.x.
+func ComponentARNToSemanticVersion(inputARN string) (string, error) {
+	parsedARN, err := arn.Parse(inputARN)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing ARN (%s): %w", inputARN, err)
+	}
+
+	resourceParts := strings.Split(parsedARN.Resource, ARNSeparator)
+
+	if actual := len(resourceParts); actual != 3 && actual != 4 {
+		return "", fmt.Errorf("expected 3 or 4 resource parts in ARN (%s), got: %d", inputARN, actual)
+	}
+
+	if actual, expected := resourceParts[0], ComponentResourcePrefix; actual != expected {
+		return "", fmt.Errorf("expected resource prefix %s in ARN (%s), got: %s", expected, inputARN, actual)
+	}
+
+	version := resourceParts[2]
+
+	if _, err := SemanticVersionParse(version); err != nil {
+		return "", nil
+	}
+
+	return version, nil
+}
@@ -0,0 +1,333 @@
+package imagebuilder_test
+
+import (
+	"regexp"
+	"testing"
+
+	tfimagebuilder "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/imagebuilder"
+)
+
+func TestARNsPartitionAndAccountCheck(t *testing.T) {
+	testCases := []struct {
+		TestName                string
+		InputARNs               []string
+		ExpectedError           *regexp.Regexp
+		ExpectedAccountMismatch bool
+	}{
+		{
+			TestName: "no ARNs",
+		},
+		{
+			TestName:  "empty ARNs",
+			InputARNs: []string{"", ""},
+		},
+		{
+			TestName:      "unparsable ARN",
+			InputARNs:     []string{"test"},
+			ExpectedError: regexp.MustCompile(`error parsing ARN`),
+		},
+		{
+			TestName: "same partition and account",
+			InputARNs: []string{
+				"arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/test/1.0.0",
+				"arn:aws:imagebuilder:us-east-1:123456789012:infrastructure-configuration/test",
+				"arn:aws:imagebuilder:us-east-1:123456789012:distribution-configuration/test",
+			},
+		},
+		{
+			TestName: "commercial and GovCloud partitions",
+			InputARNs: []string{
+				"arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/test/1.0.0",
+				"arn:aws:imagebuilder:us-east-1:123456789012:infrastructure-configuration/test",
+				"arn:aws-us-gov:imagebuilder:us-gov-west-1:123456789012:distribution-configuration/test",
+			},
+			ExpectedError: regexp.MustCompile(`expected partition aws in ARN \(arn:aws-us-gov:.+\), got: aws-us-gov`),
+		},
+		{
+			TestName: "GovCloud and commercial partitions",
+			InputARNs: []string{
+				"arn:aws-us-gov:imagebuilder:us-gov-west-1:123456789012:image-recipe/test/1.0.0",
+				"arn:aws:imagebuilder:us-east-1:123456789012:infrastructure-configuration/test",
+			},
+			ExpectedError: regexp.MustCompile(`expected partition aws-us-gov in ARN \(arn:aws:.+\), got: aws`),
+		},
+		{
+			TestName: "different accounts",
+			InputARNs: []string{
+				"arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/test/1.0.0",
+				"arn:aws:imagebuilder:us-east-1:210987654321:infrastructure-configuration/test",
+			},
+			ExpectedAccountMismatch: true,
+		},
+		{
+			TestName: "Amazon-managed resource",
+			InputARNs: []string{
+				"arn:aws:imagebuilder:us-east-1:aws:image-recipe/test/1.0.0",
+				"arn:aws:imagebuilder:us-east-1:123456789012:infrastructure-configuration/test",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := tfimagebuilder.ARNsPartitionAndAccountCheck(testCase.InputARNs...)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			if got != testCase.ExpectedAccountMismatch {
+				t.Errorf("got %t, expected %t", got, testCase.ExpectedAccountMismatch)
+			}
+		})
+	}
+}
+
+func TestImageARNToRegion(t *testing.T) {
+	testCases := []struct {
+		TestName       string
+		InputARN       string
+		ExpectedError  *regexp.Regexp
+		ExpectedRegion string
+	}{
+		{
+			TestName:      "empty ARN",
+			InputARN:      "",
+			ExpectedError: regexp.MustCompile(`error parsing ARN`),
+		},
+		{
+			TestName:      "invalid ARN resource prefix",
+			InputARN:      "arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/test/1.0.0",
+			ExpectedError: regexp.MustCompile(`expected resource prefix image`),
+		},
+		{
+			TestName:      "missing region",
+			InputARN:      "arn:aws:imagebuilder::123456789012:image/test/1.0.0/1",
+			ExpectedError: regexp.MustCompile(`expected region`),
+		},
+		{
+			TestName:       "image ARN",
+			InputARN:       "arn:aws:imagebuilder:us-west-2:123456789012:image/test/1.0.0/1",
+			ExpectedRegion: "us-west-2",
+		},
+		{
+			TestName:       "Amazon-managed image ARN",
+			InputARN:       "arn:aws:imagebuilder:eu-west-1:aws:image/amazon-linux-2-x86/x.x.x",
+			ExpectedRegion: "eu-west-1",
+		},
+		{
+			TestName:       "GovCloud image ARN",
+			InputARN:       "arn:aws-us-gov:imagebuilder:us-gov-west-1:123456789012:image/test/1.0.0/2",
+			ExpectedRegion: "us-gov-west-1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := tfimagebuilder.ImageARNToRegion(testCase.InputARN)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			if got != testCase.ExpectedRegion {
+				t.Errorf("got %s, expected %s", got, testCase.ExpectedRegion)
+			}
+		})
+	}
+}
+
+func TestRecipeARNToSemanticVersion(t *testing.T) {
+	testCases := []struct {
+		TestName                string
+		InputARN                string
+		ExpectedError           *regexp.Regexp
+		ExpectedSemanticVersion string
+	}{
+		{
+			TestName:      "empty ARN",
+			InputARN:      "",
+			ExpectedError: regexp.MustCompile(`error parsing ARN`),
+		},
+		{
+			TestName:      "invalid ARN resource parts",
+			InputARN:      "arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/test",
+			ExpectedError: regexp.MustCompile(`expected 3 resource parts`),
+		},
+		{
+			TestName:      "invalid ARN resource prefix",
+			InputARN:      "arn:aws:imagebuilder:us-east-1:123456789012:component/test/1.0.0",
+			ExpectedError: regexp.MustCompile(`expected resource prefix image-recipe or container-recipe`),
+		},
+		{
+			TestName:                "image recipe ARN",
+			InputARN:                "arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/test/1.10.0",
+			ExpectedSemanticVersion: "1.10.0",
+		},
+		{
+			TestName:                "container recipe ARN",
+			InputARN:                "arn:aws:imagebuilder:us-east-1:123456789012:container-recipe/test/2.0.1",
+			ExpectedSemanticVersion: "2.0.1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := tfimagebuilder.RecipeARNToSemanticVersion(testCase.InputARN)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			if got != testCase.ExpectedSemanticVersion {
+				t.Errorf("got %s, expected %s", got, testCase.ExpectedSemanticVersion)
+			}
+		})
+	}
+}
+
+func TestRecipeARNToUnversionedARN(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputARN      string
+		ExpectedError *regexp.Regexp
+		ExpectedARN   string
+	}{
+		{
+			TestName:      "empty ARN",
+			InputARN:      "",
+			ExpectedError: regexp.MustCompile(`error parsing ARN`),
+		},
+		{
+			TestName:      "invalid ARN resource parts",
+			InputARN:      "arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/test",
+			ExpectedError: regexp.MustCompile(`expected 3 resource parts`),
+		},
+		{
+			TestName:      "invalid ARN resource prefix",
+			InputARN:      "arn:aws:imagebuilder:us-east-1:123456789012:component/test/1.0.0",
+			ExpectedError: regexp.MustCompile(`expected resource prefix image-recipe or container-recipe`),
+		},
+		{
+			TestName:    "image recipe ARN",
+			InputARN:    "arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/test/1.10.0",
+			ExpectedARN: "arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/test",
+		},
+		{
+			TestName:    "container recipe ARN",
+			InputARN:    "arn:aws-us-gov:imagebuilder:us-gov-west-1:123456789012:container-recipe/test/2.0.1",
+			ExpectedARN: "arn:aws-us-gov:imagebuilder:us-gov-west-1:123456789012:container-recipe/test",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := tfimagebuilder.RecipeARNToUnversionedARN(testCase.InputARN)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			if got != testCase.ExpectedARN {
+				t.Errorf("got %s, expected %s", got, testCase.ExpectedARN)
+			}
+		})
+	}
+}
+
+func TestComponentARNToSemanticVersion(t *testing.T) {
+	testCases := []struct {
+		TestName                string
+		InputARN                string
+		ExpectedError           *regexp.Regexp
+		ExpectedSemanticVersion string
+	}{
+		{
+			TestName:      "empty ARN",
+			InputARN:      "",
+			ExpectedError: regexp.MustCompile(`error parsing ARN`),
+		},
+		{
+			TestName:      "invalid ARN resource parts",
+			InputARN:      "arn:aws:imagebuilder:us-east-1:123456789012:component/test",
+			ExpectedError: regexp.MustCompile(`expected 3 or 4 resource parts`),
+		},
+		{
+			TestName:      "invalid ARN resource prefix",
+			InputARN:      "arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/test/1.0.0",
+			ExpectedError: regexp.MustCompile(`expected resource prefix component`),
+		},
+		{
+			TestName:                "component version ARN",
+			InputARN:                "arn:aws:imagebuilder:us-east-1:123456789012:component/test/1.10.0",
+			ExpectedSemanticVersion: "1.10.0",
+		},
+		{
+			TestName:                "component build version ARN",
+			InputARN:                "arn:aws:imagebuilder:us-east-1:aws:component/update-linux/1.0.2/1",
+			ExpectedSemanticVersion: "1.0.2",
+		},
+		{
+			TestName: "component wildcard version ARN",
+			InputARN: "arn:aws:imagebuilder:us-east-1:aws:component/update-linux/x.x.x",
+		},
+		{
+			TestName: "component partial wildcard version ARN",
+			InputARN: "arn:aws:imagebuilder:us-east-1:aws:component/update-linux/1.0.x",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := tfimagebuilder.ComponentARNToSemanticVersion(testCase.InputARN)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			if got != testCase.ExpectedSemanticVersion {
+				t.Errorf("got %s, expected %s", got, testCase.ExpectedSemanticVersion)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package imagebuilder
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+const (
+	// Default maximum number of concurrent GetImage calls when reading multiple Images.
+	DefaultImagesReadConcurrency = 10
+)
+
+// ImageGetFunc reads an Image by its build version Amazon Resource Name (ARN).
+// A nil Image without an error indicates the Image was not found.
+type ImageGetFunc func(ctx context.Context, imageBuildVersionArn string) (*imagebuilder.Image, error)
+
+// ImagesByARNs reads Images by build version Amazon Resource Name (ARN) via the GetImage API,
+// with at most concurrency calls in flight. See ImagesByARNsWithFunc.
+// GetImage also accepts semantic version ARNs without a build number (e.g. image/example/1.0.0),
+// which resolve to the latest build of that version only; earlier builds are not returned.
+func ImagesByARNs(ctx context.Context, conn *imagebuilder.Imagebuilder, imageBuildVersionArns []string, concurrency int) (map[string]*imagebuilder.Image, error) {
+	return ImagesByARNsWithFunc(ctx, imageBuildVersionArns, concurrency, func(ctx context.Context, imageBuildVersionArn string) (*imagebuilder.Image, error) {
+		output, err := conn.GetImageWithContext(ctx, &imagebuilder.GetImageInput{
+			ImageBuildVersionArn: aws.String(imageBuildVersionArn),
+		})
+
+		if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			return nil, nil
+		}
+
+		return output.Image, nil
+	})
+}
+
+// ImagesByARNsWithFunc reads Images by build version Amazon Resource Name (ARN) with get, using a pool
+// of at most concurrency workers, or DefaultImagesReadConcurrency when concurrency is less than 1.
+// The result maps each ARN to its Image, keyed by the ARN as given, so a semantic version ARN maps to
+// whichever build get resolves it to. Duplicate ARNs are read once and Images not found are omitted.
+// The first error stops the remaining reads and is returned.
+func ImagesByARNsWithFunc(ctx context.Context, imageBuildVersionArns []string, concurrency int, get ImageGetFunc) (map[string]*imagebuilder.Image, error) {
+	if concurrency < 1 {
+		concurrency = DefaultImagesReadConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	arns := make(chan string)
+	images := make(map[string]*imagebuilder.Image)
+
+	var mutex sync.Mutex
+	var firstErr error
+	var wg sync.WaitGroup
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for arn := range arns {
+				if ctx.Err() != nil {
+					continue
+				}
+
+				image, err := get(ctx, arn)
+
+				mutex.Lock()
+
+				if err != nil {
+					if firstErr == nil {
+						firstErr = fmt.Errorf("error reading Image Builder Image (%s): %w", arn, err)
+						cancel()
+					}
+				} else if image != nil {
+					images[arn] = image
+				}
+
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	seen := make(map[string]struct{}, len(imageBuildVersionArns))
+
+	for _, arn := range imageBuildVersionArns {
+		if _, ok := seen[arn]; ok {
+			continue
+		}
+
+		seen[arn] = struct{}{}
+
+		select {
+		case arns <- arn:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	close(arns)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
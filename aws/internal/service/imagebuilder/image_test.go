@@ -0,0 +1,174 @@
+package imagebuilder_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	tfimagebuilder "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/imagebuilder"
+)
+
+func TestImagesByARNsWithFunc(t *testing.T) {
+	testARN := func(i int) string {
+		return fmt.Sprintf("arn:aws:imagebuilder:us-east-1:123456789012:image/test/1.0.0/%d", i)
+	}
+
+	testCases := []struct {
+		TestName              string
+		InputARNs             []string
+		Concurrency           int
+		NotFoundARNs          []string
+		ErrorARN              string
+		ExpectedARNs          []string
+		ExpectedError         *regexp.Regexp
+		ExpectedMaxInFlight   int
+		ExpectedDistinctCalls int
+	}{
+		{
+			TestName: "no ARNs",
+		},
+		{
+			TestName:              "found",
+			InputARNs:             []string{testARN(1), testARN(2), testARN(3)},
+			Concurrency:           2,
+			ExpectedARNs:          []string{testARN(1), testARN(2), testARN(3)},
+			ExpectedMaxInFlight:   2,
+			ExpectedDistinctCalls: 3,
+		},
+		{
+			TestName:              "duplicate ARNs",
+			InputARNs:             []string{testARN(1), testARN(1), testARN(2)},
+			Concurrency:           1,
+			ExpectedARNs:          []string{testARN(1), testARN(2)},
+			ExpectedMaxInFlight:   1,
+			ExpectedDistinctCalls: 2,
+		},
+		{
+			TestName:              "not found",
+			InputARNs:             []string{testARN(1), testARN(2)},
+			Concurrency:           2,
+			NotFoundARNs:          []string{testARN(2)},
+			ExpectedARNs:          []string{testARN(1)},
+			ExpectedMaxInFlight:   2,
+			ExpectedDistinctCalls: 2,
+		},
+		{
+			TestName:            "default concurrency",
+			InputARNs:           []string{testARN(1), testARN(2), testARN(3), testARN(4), testARN(5), testARN(6), testARN(7), testARN(8), testARN(9), testARN(10), testARN(11), testARN(12)},
+			ExpectedARNs:        []string{testARN(1), testARN(2), testARN(3), testARN(4), testARN(5), testARN(6), testARN(7), testARN(8), testARN(9), testARN(10), testARN(11), testARN(12)},
+			ExpectedMaxInFlight: tfimagebuilder.DefaultImagesReadConcurrency,
+		},
+		{
+			TestName:      "error",
+			InputARNs:     []string{testARN(1), testARN(2), testARN(3)},
+			Concurrency:   1,
+			ErrorARN:      testARN(2),
+			ExpectedError: regexp.MustCompile(`error reading Image Builder Image \(.+/2\): test`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			var mutex sync.Mutex
+			var inFlight, maxInFlight int
+			calls := make(map[string]int)
+
+			get := func(ctx context.Context, imageBuildVersionArn string) (*imagebuilder.Image, error) {
+				mutex.Lock()
+				calls[imageBuildVersionArn]++
+				inFlight++
+				if inFlight > maxInFlight {
+					maxInFlight = inFlight
+				}
+				mutex.Unlock()
+
+				time.Sleep(10 * time.Millisecond)
+
+				mutex.Lock()
+				inFlight--
+				mutex.Unlock()
+
+				if imageBuildVersionArn == testCase.ErrorARN {
+					return nil, errors.New("test")
+				}
+
+				for _, notFoundARN := range testCase.NotFoundARNs {
+					if imageBuildVersionArn == notFoundARN {
+						return nil, nil
+					}
+				}
+
+				return &imagebuilder.Image{Arn: aws.String(imageBuildVersionArn)}, nil
+			}
+
+			got, err := tfimagebuilder.ImagesByARNsWithFunc(context.Background(), testCase.InputARNs, testCase.Concurrency, get)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			if err != nil {
+				if calls[testCase.InputARNs[len(testCase.InputARNs)-1]] != 0 {
+					t.Errorf("expected reads after the error to be skipped")
+				}
+
+				return
+			}
+
+			if len(got) != len(testCase.ExpectedARNs) {
+				t.Fatalf("got %d images, expected %d", len(got), len(testCase.ExpectedARNs))
+			}
+
+			for _, expectedARN := range testCase.ExpectedARNs {
+				if image, ok := got[expectedARN]; !ok || aws.StringValue(image.Arn) != expectedARN {
+					t.Errorf("expected image %s", expectedARN)
+				}
+			}
+
+			if maxInFlight > testCase.ExpectedMaxInFlight {
+				t.Errorf("got %d concurrent reads, expected at most %d", maxInFlight, testCase.ExpectedMaxInFlight)
+			}
+
+			if testCase.ExpectedDistinctCalls > 0 {
+				for arn, count := range calls {
+					if count != 1 {
+						t.Errorf("got %d reads of %s, expected 1", count, arn)
+					}
+				}
+
+				if len(calls) != testCase.ExpectedDistinctCalls {
+					t.Errorf("got %d distinct reads, expected %d", len(calls), testCase.ExpectedDistinctCalls)
+				}
+			}
+		})
+	}
+}
+
+func TestImagesByARNsWithFuncContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	get := func(ctx context.Context, imageBuildVersionArn string) (*imagebuilder.Image, error) {
+		return &imagebuilder.Image{Arn: aws.String(imageBuildVersionArn)}, nil
+	}
+
+	_, err := tfimagebuilder.ImagesByARNsWithFunc(ctx, []string{"arn:aws:imagebuilder:us-east-1:123456789012:image/test/1.0.0/1"}, 1, get)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled error, got: %v", err)
+	}
+}
@@ -0,0 +1,149 @@
+package imagebuilder
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	ImageVersionSeparator = "/"
+)
+
+// ImageVersionParse parses an Image Builder Image version (e.g. 1.0.0/3)
+// into its semantic version and build number.
+// A build number of 0 is returned when the build suffix is absent.
+func ImageVersionParse(version string) (string, int, error) {
+	parts := strings.Split(version, ImageVersionSeparator)
+
+	if len(parts) > 2 || parts[0] == "" {
+		return "", 0, fmt.Errorf("unexpected format for Image Builder version (%s), expected SEMANTIC-VERSION or SEMANTIC-VERSION/BUILD-NUMBER", version)
+	}
+
+	if len(parts) == 1 {
+		return parts[0], 0, nil
+	}
+
+	buildNumber, err := strconv.Atoi(parts[1])
+
+	if err != nil {
+		return "", 0, fmt.Errorf("error parsing build number in Image Builder version (%s): %w", version, err)
+	}
+
+	return parts[0], buildNumber, nil
+}
+
+const (
+	SemanticVersionInitial   = "1.0.0"
+	SemanticVersionSeparator = "."
+	SemanticVersionWildcard  = "x"
+)
+
+// SemanticVersionParse parses an Image Builder semantic version (e.g. 1.10.0)
+// into its major, minor and patch components.
+func SemanticVersionParse(version string) ([3]int, error) {
+	var result [3]int
+
+	parts := strings.Split(version, SemanticVersionSeparator)
+
+	if len(parts) != len(result) {
+		return result, fmt.Errorf("unexpected format for Image Builder semantic version (%s), expected MAJOR.MINOR.PATCH", version)
+	}
+
+	for i, part := range parts {
+		v, err := strconv.Atoi(part)
+
+		if err != nil || v < 0 {
+			return result, fmt.Errorf("unexpected format for Image Builder semantic version (%s), expected non-negative integer components", version)
+		}
+
+		result[i] = v
+	}
+
+	return result, nil
+}
+
+// SemanticVersionMatch reports whether an Image Builder semantic version (e.g. 2021.1.15)
+// matches a version filter, in which any component may be the x wildcard (e.g. 2021.x.x).
+func SemanticVersionMatch(filter, version string) (bool, error) {
+	filterParts := strings.Split(filter, SemanticVersionSeparator)
+
+	if len(filterParts) != 3 {
+		return false, fmt.Errorf("unexpected format for Image Builder semantic version filter (%s), expected MAJOR.MINOR.PATCH", filter)
+	}
+
+	parsed, err := SemanticVersionParse(version)
+
+	if err != nil {
+		return false, err
+	}
+
+	for i, part := range filterParts {
+		if part == SemanticVersionWildcard {
+			continue
+		}
+
+		v, err := strconv.Atoi(part)
+
+		if err != nil || v < 0 {
+			return false, fmt.Errorf("unexpected format for Image Builder semantic version filter (%s), expected non-negative integer or %s components", filter, SemanticVersionWildcard)
+		}
+
+		if v != parsed[i] {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// SemanticVersionCompare compares two Image Builder semantic versions numerically,
+// returning -1, 0 or 1 when v1 is lower than, equal to or greater than v2.
+func SemanticVersionCompare(v1, v2 string) (int, error) {
+	parsed1, err := SemanticVersionParse(v1)
+
+	if err != nil {
+		return 0, err
+	}
+
+	parsed2, err := SemanticVersionParse(v2)
+
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range parsed1 {
+		if parsed1[i] < parsed2[i] {
+			return -1, nil
+		}
+
+		if parsed1[i] > parsed2[i] {
+			return 1, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// SemanticVersionNextPatch returns the semantic version following version with the patch component incremented.
+func SemanticVersionNextPatch(version string) (string, error) {
+	parsed, err := SemanticVersionParse(version)
+
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d.%d.%d", parsed[0], parsed[1], parsed[2]+1), nil
+}
+
+// SemanticVersionNextMinor returns the semantic version following version with the minor component incremented
+// and the patch component reset.
+func SemanticVersionNextMinor(version string) (string, error) {
+	parsed, err := SemanticVersionParse(version)
+
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d.%d.0", parsed[0], parsed[1]+1), nil
+}
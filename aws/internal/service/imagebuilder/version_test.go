@@ -0,0 +1,299 @@
+package imagebuilder_test
+
+import (
+	"regexp"
+	"testing"
+
+	tfimagebuilder "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/imagebuilder"
+)
+
+func TestImageVersionParse(t *testing.T) {
+	testCases := []struct {
+		TestName                string
+		InputVersion            string
+		ExpectedError           *regexp.Regexp
+		ExpectedSemanticVersion string
+		ExpectedBuildNumber     int
+	}{
+		{
+			TestName:      "empty version",
+			InputVersion:  "",
+			ExpectedError: regexp.MustCompile(`unexpected format`),
+		},
+		{
+			TestName:      "too many parts",
+			InputVersion:  "1.0.0/3/1",
+			ExpectedError: regexp.MustCompile(`unexpected format`),
+		},
+		{
+			TestName:      "invalid build number",
+			InputVersion:  "1.0.0/x",
+			ExpectedError: regexp.MustCompile(`error parsing build number`),
+		},
+		{
+			TestName:                "semantic version only",
+			InputVersion:            "1.0.0",
+			ExpectedSemanticVersion: "1.0.0",
+		},
+		{
+			TestName:                "semantic version and build number",
+			InputVersion:            "1.0.0/3",
+			ExpectedSemanticVersion: "1.0.0",
+			ExpectedBuildNumber:     3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotSemanticVersion, gotBuildNumber, err := tfimagebuilder.ImageVersionParse(testCase.InputVersion)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			if gotSemanticVersion != testCase.ExpectedSemanticVersion {
+				t.Errorf("got semantic version %s, expected %s", gotSemanticVersion, testCase.ExpectedSemanticVersion)
+			}
+
+			if gotBuildNumber != testCase.ExpectedBuildNumber {
+				t.Errorf("got build number %d, expected %d", gotBuildNumber, testCase.ExpectedBuildNumber)
+			}
+		})
+	}
+}
+
+func TestSemanticVersionMatch(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputFilter   string
+		InputVersion  string
+		ExpectedError *regexp.Regexp
+		Expected      bool
+	}{
+		{
+			TestName:      "invalid filter format",
+			InputFilter:   "x.x",
+			InputVersion:  "1.0.0",
+			ExpectedError: regexp.MustCompile(`unexpected format for Image Builder semantic version filter`),
+		},
+		{
+			TestName:      "invalid filter component",
+			InputFilter:   "1.y.x",
+			InputVersion:  "1.0.0",
+			ExpectedError: regexp.MustCompile(`expected non-negative integer or x components`),
+		},
+		{
+			TestName:      "invalid version",
+			InputFilter:   "x.x.x",
+			InputVersion:  "1.0",
+			ExpectedError: regexp.MustCompile(`unexpected format for Image Builder semantic version`),
+		},
+		{
+			TestName:     "all wildcards",
+			InputFilter:  "x.x.x",
+			InputVersion: "2021.1.15",
+			Expected:     true,
+		},
+		{
+			TestName:     "major matches",
+			InputFilter:  "2021.x.x",
+			InputVersion: "2021.1.15",
+			Expected:     true,
+		},
+		{
+			TestName:     "major does not match",
+			InputFilter:  "2020.x.x",
+			InputVersion: "2021.1.15",
+		},
+		{
+			TestName:     "major and minor match",
+			InputFilter:  "2021.1.x",
+			InputVersion: "2021.1.15",
+			Expected:     true,
+		},
+		{
+			TestName:     "exact match",
+			InputFilter:  "2021.1.15",
+			InputVersion: "2021.1.15",
+			Expected:     true,
+		},
+		{
+			TestName:     "patch does not match",
+			InputFilter:  "2021.1.14",
+			InputVersion: "2021.1.15",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := tfimagebuilder.SemanticVersionMatch(testCase.InputFilter, testCase.InputVersion)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestSemanticVersionCompare(t *testing.T) {
+	testCases := []struct {
+		TestName       string
+		InputVersion1  string
+		InputVersion2  string
+		ExpectedError  *regexp.Regexp
+		ExpectedResult int
+	}{
+		{
+			TestName:      "empty version",
+			InputVersion1: "",
+			InputVersion2: "1.0.0",
+			ExpectedError: regexp.MustCompile(`expected MAJOR.MINOR.PATCH`),
+		},
+		{
+			TestName:      "too few parts",
+			InputVersion1: "1.0.0",
+			InputVersion2: "1.0",
+			ExpectedError: regexp.MustCompile(`expected MAJOR.MINOR.PATCH`),
+		},
+		{
+			TestName:      "non-integer component",
+			InputVersion1: "1.x.0",
+			InputVersion2: "1.0.0",
+			ExpectedError: regexp.MustCompile(`expected non-negative integer components`),
+		},
+		{
+			TestName:      "negative component",
+			InputVersion1: "1.0.0",
+			InputVersion2: "1.-1.0",
+			ExpectedError: regexp.MustCompile(`expected non-negative integer components`),
+		},
+		{
+			TestName:       "equal",
+			InputVersion1:  "1.2.3",
+			InputVersion2:  "1.2.3",
+			ExpectedResult: 0,
+		},
+		{
+			TestName:       "lower patch",
+			InputVersion1:  "1.2.3",
+			InputVersion2:  "1.2.4",
+			ExpectedResult: -1,
+		},
+		{
+			TestName:       "greater major",
+			InputVersion1:  "2.0.0",
+			InputVersion2:  "1.99.99",
+			ExpectedResult: 1,
+		},
+		{
+			TestName:       "numeric minor",
+			InputVersion1:  "1.9.0",
+			InputVersion2:  "1.10.0",
+			ExpectedResult: -1,
+		},
+		{
+			TestName:       "numeric patch",
+			InputVersion1:  "1.0.10",
+			InputVersion2:  "1.0.9",
+			ExpectedResult: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := tfimagebuilder.SemanticVersionCompare(testCase.InputVersion1, testCase.InputVersion2)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			if got != testCase.ExpectedResult {
+				t.Errorf("got %d, expected %d", got, testCase.ExpectedResult)
+			}
+		})
+	}
+}
+
+func TestSemanticVersionNext(t *testing.T) {
+	testCases := []struct {
+		TestName          string
+		InputVersion      string
+		ExpectedError     *regexp.Regexp
+		ExpectedNextPatch string
+		ExpectedNextMinor string
+	}{
+		{
+			TestName:      "invalid version",
+			InputVersion:  "1.0",
+			ExpectedError: regexp.MustCompile(`expected MAJOR.MINOR.PATCH`),
+		},
+		{
+			TestName:          "initial version",
+			InputVersion:      "1.0.0",
+			ExpectedNextPatch: "1.0.1",
+			ExpectedNextMinor: "1.1.0",
+		},
+		{
+			TestName:          "double digit components",
+			InputVersion:      "1.9.19",
+			ExpectedNextPatch: "1.9.20",
+			ExpectedNextMinor: "1.10.0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotNextPatch, err := tfimagebuilder.SemanticVersionNextPatch(testCase.InputVersion)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			gotNextMinor, _ := tfimagebuilder.SemanticVersionNextMinor(testCase.InputVersion)
+
+			if gotNextPatch != testCase.ExpectedNextPatch {
+				t.Errorf("got next patch version %s, expected %s", gotNextPatch, testCase.ExpectedNextPatch)
+			}
+
+			if gotNextMinor != testCase.ExpectedNextMinor {
+				t.Errorf("got next minor version %s, expected %s", gotNextMinor, testCase.ExpectedNextMinor)
+			}
+		})
+	}
+}
@@ -1,21 +1,31 @@
 package waiter
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 // ImageStatus fetches the Image and its Status
-func ImageStatus(conn *imagebuilder.Imagebuilder, imageBuildVersionArn string) resource.StateRefreshFunc {
+func ImageStatus(ctx context.Context, conn *imagebuilder.Imagebuilder, imageBuildVersionArn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &imagebuilder.GetImageInput{
 			ImageBuildVersionArn: aws.String(imageBuildVersionArn),
 		}
 
-		output, err := conn.GetImage(input)
+		output, err := conn.GetImageWithContext(ctx, input)
+
+		// The build record is eventually consistent immediately after creation,
+		// so a not found error is returned as a nil result for the waiter to retry.
+		if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+			return nil, "", nil
+		}
 
 		if err != nil {
 			return nil, imagebuilder.ImageStatusPending, err
@@ -27,10 +37,242 @@ func ImageStatus(conn *imagebuilder.Imagebuilder, imageBuildVersionArn string) r
 
 		status := aws.StringValue(output.Image.State.Status)
 
-		if status == imagebuilder.ImageStatusFailed {
-			return output.Image, status, fmt.Errorf("%s", aws.StringValue(output.Image.State.Reason))
+		return output.Image, status, ImageStateError(output.Image.State)
+	}
+}
+
+// ImageDeletionStatus fetches the Image and its Status during deletion.
+// A nil result is returned once the Image is not found or has the DELETED status.
+// Unlike ImageStatus, a CANCELLED or FAILED Image is not an error, since those can also be deleted.
+func ImageDeletionStatus(ctx context.Context, conn *imagebuilder.Imagebuilder, imageBuildVersionArn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &imagebuilder.GetImageInput{
+			ImageBuildVersionArn: aws.String(imageBuildVersionArn),
+		}
+
+		output, err := conn.GetImageWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.Image == nil || output.Image.State == nil {
+			return nil, "", nil
+		}
+
+		status := aws.StringValue(output.Image.State.Status)
+
+		if status == imagebuilder.ImageStatusDeleted {
+			return nil, "", nil
 		}
 
 		return output.Image, status, nil
 	}
 }
+
+// ImageStateError returns an error describing a cancelled or failed Image build, including the reason.
+// Other states return nil.
+func ImageStateError(state *imagebuilder.ImageState) error {
+	if state == nil {
+		return nil
+	}
+
+	switch status, reason := aws.StringValue(state.Status), aws.StringValue(state.Reason); status {
+	case imagebuilder.ImageStatusCancelled:
+		if reason == "" {
+			return fmt.Errorf("image build cancelled")
+		}
+
+		return fmt.Errorf("image build cancelled: %s", reason)
+	case imagebuilder.ImageStatusFailed:
+		if reason == "" {
+			return fmt.Errorf("image build failed")
+		}
+
+		return fmt.Errorf("image build failed: %s", reason)
+	}
+
+	return nil
+}
+
+// ImageTimeoutError adds the status and reason of the last observed Image state to an error from
+// waiting past the timeout or context deadline. Other errors are returned unchanged.
+func ImageTimeoutError(err error, state *imagebuilder.ImageState) error {
+	if err == nil || state == nil {
+		return err
+	}
+
+	status, reason := aws.StringValue(state.Status), aws.StringValue(state.Reason)
+
+	if reason == "" {
+		return err
+	}
+
+	var te *resource.TimeoutError
+
+	if errors.As(err, &te) {
+		if te.LastError == nil {
+			te.LastError = fmt.Errorf("last status reason: %s", reason)
+		}
+
+		return err
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("%w (last status %s: %s)", err, status, reason)
+	}
+
+	return err
+}
+
+// ImageStatusBuildingWarning wraps an Image status refresh function, calling warn each time the Image
+// has spent another threshold in the BUILDING status with the elapsed time and the current status reason,
+// which usually describes the executing component.
+func ImageStatusBuildingWarning(refresh resource.StateRefreshFunc, threshold time.Duration, warn func(elapsed time.Duration, reason string)) resource.StateRefreshFunc {
+	var buildingSince time.Time
+	var warnings int64
+
+	return func() (interface{}, string, error) {
+		result, status, err := refresh()
+
+		if status != imagebuilder.ImageStatusBuilding {
+			buildingSince = time.Time{}
+			warnings = 0
+
+			return result, status, err
+		}
+
+		if buildingSince.IsZero() {
+			buildingSince = time.Now()
+		}
+
+		if elapsed := time.Since(buildingSince); elapsed >= threshold*time.Duration(warnings+1) {
+			var reason string
+
+			if image, ok := result.(*imagebuilder.Image); ok && image.State != nil {
+				reason = aws.StringValue(image.State.Reason)
+			}
+
+			warn(elapsed, reason)
+			warnings++
+		}
+
+		return result, status, err
+	}
+}
+
+// ImageStatusExpectedDurationWarning wraps an Image status refresh function, calling warn once with the elapsed time
+// and current status when the Image is still not AVAILABLE after the expected duration since the first refresh.
+func ImageStatusExpectedDurationWarning(refresh resource.StateRefreshFunc, expected time.Duration, warn func(elapsed time.Duration, status string)) resource.StateRefreshFunc {
+	var start time.Time
+	var warned bool
+
+	return func() (interface{}, string, error) {
+		if start.IsZero() {
+			start = time.Now()
+		}
+
+		result, status, err := refresh()
+
+		if warned || err != nil || status == imagebuilder.ImageStatusAvailable {
+			return result, status, err
+		}
+
+		if elapsed := time.Since(start); elapsed >= expected {
+			warn(elapsed, status)
+			warned = true
+		}
+
+		return result, status, err
+	}
+}
+
+// ImageStatusDistributionProgress wraps an Image status refresh function, calling progress each time the number
+// of regions with an output AMI changes while the Image is in the DISTRIBUTING status. The expected number of
+// regions is taken from the Image distribution configuration and is zero when it cannot be resolved.
+func ImageStatusDistributionProgress(refresh resource.StateRefreshFunc, progress func(completed int, expected int)) resource.StateRefreshFunc {
+	lastCompleted := -1
+
+	return func() (interface{}, string, error) {
+		result, status, err := refresh()
+
+		if status != imagebuilder.ImageStatusDistributing {
+			lastCompleted = -1
+
+			return result, status, err
+		}
+
+		image, ok := result.(*imagebuilder.Image)
+
+		if !ok {
+			return result, status, err
+		}
+
+		completed := len(ImageOutputAmiRegions(image))
+
+		if completed != lastCompleted {
+			progress(completed, len(ImageDistributionRegions(image)))
+			lastCompleted = completed
+		}
+
+		return result, status, err
+	}
+}
+
+// ImageDistributionRegions returns the unique regions in the Image distribution configuration.
+func ImageDistributionRegions(image *imagebuilder.Image) []string {
+	if image == nil || image.DistributionConfiguration == nil {
+		return nil
+	}
+
+	var regions []string
+	seen := make(map[string]bool)
+
+	for _, distribution := range image.DistributionConfiguration.Distributions {
+		if distribution == nil {
+			continue
+		}
+
+		region := aws.StringValue(distribution.Region)
+
+		if region == "" || seen[region] {
+			continue
+		}
+
+		seen[region] = true
+		regions = append(regions, region)
+	}
+
+	return regions
+}
+
+// ImageOutputAmiRegions returns the unique regions with an output AMI for the Image.
+func ImageOutputAmiRegions(image *imagebuilder.Image) []string {
+	if image == nil || image.OutputResources == nil {
+		return nil
+	}
+
+	var regions []string
+	seen := make(map[string]bool)
+
+	for _, ami := range image.OutputResources.Amis {
+		if ami == nil || aws.StringValue(ami.Image) == "" {
+			continue
+		}
+
+		region := aws.StringValue(ami.Region)
+
+		if region == "" || seen[region] {
+			continue
+		}
+
+		seen[region] = true
+		regions = append(regions, region)
+	}
+
+	return regions
+}
@@ -0,0 +1,472 @@
+package waiter_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/imagebuilder/waiter"
+)
+
+func TestImageStatus(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Region: aws.String("us-east-1"),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := imagebuilder.New(sess)
+
+	// GetImage returns not found once right after creation, then the build record.
+	var calls int
+
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		calls++
+
+		if calls == 1 {
+			r.Error = awserr.New(imagebuilder.ErrCodeResourceNotFoundException, "Image not found", nil)
+			return
+		}
+
+		r.Data.(*imagebuilder.GetImageOutput).Image = &imagebuilder.Image{
+			State: &imagebuilder.ImageState{
+				Status: aws.String(imagebuilder.ImageStatusBuilding),
+			},
+		}
+	})
+
+	refresh := waiter.ImageStatus(context.Background(), conn, "arn:aws:imagebuilder:us-east-1:123456789012:image/test/1.0.0/1")
+
+	result, status, err := refresh()
+
+	if err != nil {
+		t.Fatalf("got unexpected error for not found: %s", err)
+	}
+
+	if result != nil || status != "" {
+		t.Fatalf("got result %v and status %q for not found, expected nil and empty status", result, status)
+	}
+
+	result, status, err = refresh()
+
+	if err != nil {
+		t.Fatalf("got unexpected error for building: %s", err)
+	}
+
+	if _, ok := result.(*imagebuilder.Image); !ok {
+		t.Fatalf("got result %T for building, expected *imagebuilder.Image", result)
+	}
+
+	if status != imagebuilder.ImageStatusBuilding {
+		t.Errorf("got status %q, expected %q", status, imagebuilder.ImageStatusBuilding)
+	}
+}
+
+func TestImageStateError(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		State         *imagebuilder.ImageState
+		ExpectedError string
+	}{
+		{
+			TestName: "nil state",
+		},
+		{
+			TestName: "building",
+			State: &imagebuilder.ImageState{
+				Status: aws.String(imagebuilder.ImageStatusBuilding),
+			},
+		},
+		{
+			TestName: "available",
+			State: &imagebuilder.ImageState{
+				Status: aws.String(imagebuilder.ImageStatusAvailable),
+			},
+		},
+		{
+			TestName: "cancelled",
+			State: &imagebuilder.ImageState{
+				Status: aws.String(imagebuilder.ImageStatusCancelled),
+				Reason: aws.String("Image creation cancelled by user"),
+			},
+			ExpectedError: "image build cancelled: Image creation cancelled by user",
+		},
+		{
+			TestName: "cancelled without reason",
+			State: &imagebuilder.ImageState{
+				Status: aws.String(imagebuilder.ImageStatusCancelled),
+			},
+			ExpectedError: "image build cancelled",
+		},
+		{
+			TestName: "failed",
+			State: &imagebuilder.ImageState{
+				Status: aws.String(imagebuilder.ImageStatusFailed),
+				Reason: aws.String("Image build failed in the testing phase"),
+			},
+			ExpectedError: "image build failed: Image build failed in the testing phase",
+		},
+		{
+			TestName: "failed without reason",
+			State: &imagebuilder.ImageState{
+				Status: aws.String(imagebuilder.ImageStatusFailed),
+			},
+			ExpectedError: "image build failed",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := waiter.ImageStateError(testCase.State)
+
+			if err == nil && testCase.ExpectedError != "" {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError)
+			}
+
+			if err != nil && testCase.ExpectedError == "" {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && err.Error() != testCase.ExpectedError {
+				t.Errorf("got error %s, expected %s", err, testCase.ExpectedError)
+			}
+		})
+	}
+}
+
+func TestImageTimeoutError(t *testing.T) {
+	buildingState := &imagebuilder.ImageState{
+		Reason: aws.String("Executing component update-linux"),
+		Status: aws.String(imagebuilder.ImageStatusBuilding),
+	}
+
+	testCases := []struct {
+		TestName      string
+		Err           error
+		State         *imagebuilder.ImageState
+		ExpectedError string
+	}{
+		{
+			TestName: "no error",
+			State:    buildingState,
+		},
+		{
+			TestName:      "other error",
+			Err:           errors.New("test"),
+			State:         buildingState,
+			ExpectedError: "test",
+		},
+		{
+			TestName: "timeout without state",
+			Err: &resource.TimeoutError{
+				LastState:     imagebuilder.ImageStatusBuilding,
+				ExpectedState: []string{imagebuilder.ImageStatusAvailable},
+				Timeout:       time.Minute,
+			},
+			ExpectedError: "timeout while waiting for state to become 'AVAILABLE' (last state: 'BUILDING', timeout: 1m0s)",
+		},
+		{
+			TestName: "timeout without reason",
+			Err: &resource.TimeoutError{
+				LastState:     imagebuilder.ImageStatusBuilding,
+				ExpectedState: []string{imagebuilder.ImageStatusAvailable},
+				Timeout:       time.Minute,
+			},
+			State: &imagebuilder.ImageState{
+				Status: aws.String(imagebuilder.ImageStatusBuilding),
+			},
+			ExpectedError: "timeout while waiting for state to become 'AVAILABLE' (last state: 'BUILDING', timeout: 1m0s)",
+		},
+		{
+			TestName: "timeout",
+			Err: &resource.TimeoutError{
+				LastState:     imagebuilder.ImageStatusBuilding,
+				ExpectedState: []string{imagebuilder.ImageStatusAvailable},
+				Timeout:       time.Minute,
+			},
+			State:         buildingState,
+			ExpectedError: "timeout while waiting for state to become 'AVAILABLE' (last state: 'BUILDING', timeout: 1m0s): last status reason: Executing component update-linux",
+		},
+		{
+			TestName:      "context deadline",
+			Err:           context.DeadlineExceeded,
+			State:         buildingState,
+			ExpectedError: "context deadline exceeded (last status BUILDING: Executing component update-linux)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := waiter.ImageTimeoutError(testCase.Err, testCase.State)
+
+			if err == nil && testCase.ExpectedError != "" {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError)
+			}
+
+			if err != nil && testCase.ExpectedError == "" {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && err.Error() != testCase.ExpectedError {
+				t.Errorf("got error %s, expected %s", err, testCase.ExpectedError)
+			}
+		})
+	}
+}
+
+func TestImageStatusBuildingWarning(t *testing.T) {
+	testCases := []struct {
+		TestName         string
+		Statuses         []string
+		ExpectedWarnings int
+	}{
+		{
+			TestName:         "not building",
+			Statuses:         []string{imagebuilder.ImageStatusPending, imagebuilder.ImageStatusTesting, imagebuilder.ImageStatusAvailable},
+			ExpectedWarnings: 0,
+		},
+		{
+			TestName:         "short build",
+			Statuses:         []string{imagebuilder.ImageStatusBuilding, imagebuilder.ImageStatusTesting, imagebuilder.ImageStatusAvailable},
+			ExpectedWarnings: 0,
+		},
+		{
+			TestName:         "long build",
+			Statuses:         []string{imagebuilder.ImageStatusBuilding, imagebuilder.ImageStatusBuilding, imagebuilder.ImageStatusBuilding, imagebuilder.ImageStatusTesting},
+			ExpectedWarnings: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			var poll int
+			var reasons []string
+
+			refresh := func() (interface{}, string, error) {
+				status := testCase.Statuses[poll]
+				image := &imagebuilder.Image{
+					State: &imagebuilder.ImageState{
+						Reason: aws.String(fmt.Sprintf("Executing component %d", poll)),
+						Status: aws.String(status),
+					},
+				}
+				poll++
+
+				return image, status, nil
+			}
+
+			threshold := 10 * time.Millisecond
+			f := waiter.ImageStatusBuildingWarning(refresh, threshold, func(elapsed time.Duration, reason string) {
+				if elapsed < threshold {
+					t.Errorf("got warning after %s, expected at least %s", elapsed, threshold)
+				}
+
+				reasons = append(reasons, reason)
+			})
+
+			for range testCase.Statuses {
+				if _, _, err := f(); err != nil {
+					t.Fatalf("got unexpected error: %s", err)
+				}
+
+				time.Sleep(threshold + 5*time.Millisecond)
+			}
+
+			if actual, expected := len(reasons), testCase.ExpectedWarnings; actual != expected {
+				t.Fatalf("got %d warnings, expected %d", actual, expected)
+			}
+
+			for i, reason := range reasons {
+				if expected := fmt.Sprintf("Executing component %d", i+1); reason != expected {
+					t.Errorf("got reason %s, expected %s", reason, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestImageStatusDistributionProgress(t *testing.T) {
+	distributionConfiguration := &imagebuilder.DistributionConfiguration{
+		Distributions: []*imagebuilder.Distribution{
+			{Region: aws.String("us-east-1")},
+			{Region: aws.String("us-west-2")},
+			{Region: aws.String("eu-west-1")},
+		},
+	}
+
+	amis := func(regions ...string) []*imagebuilder.Ami {
+		var apiObjects []*imagebuilder.Ami
+
+		for i, region := range regions {
+			apiObjects = append(apiObjects, &imagebuilder.Ami{
+				Image:  aws.String(fmt.Sprintf("ami-%08d", i)),
+				Region: aws.String(region),
+			})
+		}
+
+		return apiObjects
+	}
+
+	testCases := []struct {
+		TestName                  string
+		DistributionConfiguration *imagebuilder.DistributionConfiguration
+		Statuses                  []string
+		Amis                      [][]*imagebuilder.Ami
+		ExpectedProgress          []string
+	}{
+		{
+			TestName:                  "not distributing",
+			DistributionConfiguration: distributionConfiguration,
+			Statuses:                  []string{imagebuilder.ImageStatusBuilding, imagebuilder.ImageStatusTesting},
+			Amis:                      [][]*imagebuilder.Ami{nil, nil},
+		},
+		{
+			TestName:                  "staggered regions",
+			DistributionConfiguration: distributionConfiguration,
+			Statuses: []string{
+				imagebuilder.ImageStatusDistributing,
+				imagebuilder.ImageStatusDistributing,
+				imagebuilder.ImageStatusDistributing,
+				imagebuilder.ImageStatusDistributing,
+				imagebuilder.ImageStatusDistributing,
+				imagebuilder.ImageStatusAvailable,
+			},
+			Amis: [][]*imagebuilder.Ami{
+				nil,
+				amis("us-east-1"),
+				amis("us-east-1"),
+				amis("us-east-1", "us-west-2"),
+				amis("us-east-1", "us-west-2", "eu-west-1"),
+				amis("us-east-1", "us-west-2", "eu-west-1"),
+			},
+			ExpectedProgress: []string{"0/3", "1/3", "2/3", "3/3"},
+		},
+		{
+			TestName: "unresolved distribution configuration",
+			Statuses: []string{imagebuilder.ImageStatusDistributing, imagebuilder.ImageStatusDistributing},
+			Amis: [][]*imagebuilder.Ami{
+				amis("us-east-1"),
+				amis("us-east-1", "us-west-2"),
+			},
+			ExpectedProgress: []string{"1/0", "2/0"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			var poll int
+			var progress []string
+
+			refresh := func() (interface{}, string, error) {
+				status := testCase.Statuses[poll]
+				image := &imagebuilder.Image{
+					DistributionConfiguration: testCase.DistributionConfiguration,
+					OutputResources: &imagebuilder.OutputResources{
+						Amis: testCase.Amis[poll],
+					},
+					State: &imagebuilder.ImageState{
+						Status: aws.String(status),
+					},
+				}
+				poll++
+
+				return image, status, nil
+			}
+
+			f := waiter.ImageStatusDistributionProgress(refresh, func(completed int, expected int) {
+				progress = append(progress, fmt.Sprintf("%d/%d", completed, expected))
+			})
+
+			for range testCase.Statuses {
+				if _, _, err := f(); err != nil {
+					t.Fatalf("got unexpected error: %s", err)
+				}
+			}
+
+			if actual, expected := fmt.Sprint(progress), fmt.Sprint(testCase.ExpectedProgress); actual != expected {
+				t.Errorf("got progress %s, expected %s", actual, expected)
+			}
+		})
+	}
+}
+
+func TestImageStatusExpectedDurationWarning(t *testing.T) {
+	testCases := []struct {
+		TestName         string
+		Statuses         []string
+		ExpectedWarnings int
+	}{
+		{
+			TestName: "within expected duration",
+			Statuses: []string{imagebuilder.ImageStatusBuilding, imagebuilder.ImageStatusAvailable},
+		},
+		{
+			TestName:         "exceeds expected duration",
+			Statuses:         []string{imagebuilder.ImageStatusBuilding, imagebuilder.ImageStatusBuilding, imagebuilder.ImageStatusTesting, imagebuilder.ImageStatusDistributing, imagebuilder.ImageStatusAvailable},
+			ExpectedWarnings: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			var poll int
+			var warnings []string
+
+			refresh := func() (interface{}, string, error) {
+				status := testCase.Statuses[poll]
+				image := &imagebuilder.Image{
+					State: &imagebuilder.ImageState{
+						Status: aws.String(status),
+					},
+				}
+				poll++
+
+				return image, status, nil
+			}
+
+			expected := 50 * time.Millisecond
+			f := waiter.ImageStatusExpectedDurationWarning(refresh, expected, func(elapsed time.Duration, status string) {
+				if elapsed < expected {
+					t.Errorf("got warning after %s, expected at least %s", elapsed, expected)
+				}
+
+				warnings = append(warnings, status)
+			})
+
+			for range testCase.Statuses {
+				_, status, err := f()
+
+				if err != nil {
+					t.Fatalf("got unexpected error: %s", err)
+				}
+
+				if status == imagebuilder.ImageStatusAvailable {
+					break
+				}
+
+				time.Sleep(30 * time.Millisecond)
+			}
+
+			if actual, expected := len(warnings), testCase.ExpectedWarnings; actual != expected {
+				t.Fatalf("got %d warnings, expected %d", actual, expected)
+			}
+
+			for _, status := range warnings {
+				if status == imagebuilder.ImageStatusAvailable {
+					t.Errorf("got warning for status %s", status)
+				}
+			}
+		})
+	}
+}
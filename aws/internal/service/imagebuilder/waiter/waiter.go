@@ -1,29 +1,111 @@
 package waiter
 
 import (
+	"context"
+	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+const (
+	// Maximum consecutive not found results while waiting for an Image to become available
+	ImageStatusAvailableNotFoundChecks = 10
+)
+
 // ImageStatusAvailable waits for an Image to return Available
-func ImageStatusAvailable(conn *imagebuilder.Imagebuilder, imageBuildVersionArn string, timeout time.Duration) (*imagebuilder.Image, error) {
+// PollInterval and MinTimeout are intentionally unset so that polling backs off
+// exponentially from 100ms up to the StateChangeConf maximum of 10 seconds.
+// A positive buildingWarningThreshold logs a warning each time the Image spends another threshold building.
+// A positive expectedDuration logs a warning once if the Image is not yet available after that duration.
+// While distributing, the number of regions with an output AMI is logged as it changes.
+// A cancelled or failed Image ends the wait with an error including its reason, as does a timeout.
+func ImageStatusAvailable(ctx context.Context, conn *imagebuilder.Imagebuilder, imageBuildVersionArn string, timeout time.Duration, buildingWarningThreshold time.Duration, expectedDuration time.Duration) (*imagebuilder.Image, error) {
+	var lastState *imagebuilder.ImageState
+	statusRefresh := ImageStatus(ctx, conn, imageBuildVersionArn)
+
+	refresh := func() (interface{}, string, error) {
+		result, status, err := statusRefresh()
+
+		if v, ok := result.(*imagebuilder.Image); ok && v != nil {
+			lastState = v.State
+		}
+
+		return result, status, err
+	}
+
+	if buildingWarningThreshold > 0 {
+		refresh = ImageStatusBuildingWarning(refresh, buildingWarningThreshold, func(elapsed time.Duration, reason string) {
+			log.Printf("[WARN] Image Builder Image (%s) has been building for %s: %s", imageBuildVersionArn, elapsed.Round(time.Second), reason)
+		})
+	}
+
+	if expectedDuration > 0 {
+		refresh = ImageStatusExpectedDurationWarning(refresh, expectedDuration, func(elapsed time.Duration, status string) {
+			log.Printf("[WARN] Image Builder Image (%s) is still %s after %s, exceeding the expected duration of %s", imageBuildVersionArn, status, elapsed.Round(time.Second), expectedDuration)
+		})
+	}
+
+	refresh = ImageStatusDistributionProgress(refresh, func(completed int, expected int) {
+		if expected == 0 {
+			log.Printf("[INFO] Image Builder Image (%s) distribution progress: %d regions complete", imageBuildVersionArn, completed)
+
+			return
+		}
+
+		log.Printf("[INFO] Image Builder Image (%s) distribution progress: %d of %d regions complete", imageBuildVersionArn, completed, expected)
+	})
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			imagebuilder.ImageStatusBuilding,
+			imagebuilder.ImageStatusCreating,
+			imagebuilder.ImageStatusDistributing,
+			imagebuilder.ImageStatusIntegrating,
+			imagebuilder.ImageStatusPending,
+			imagebuilder.ImageStatusTesting,
+		},
+		Target:         []string{imagebuilder.ImageStatusAvailable},
+		Refresh:        refresh,
+		Timeout:        timeout,
+		NotFoundChecks: ImageStatusAvailableNotFoundChecks,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	// Cancelled and failed builds end the wait with their reason via ImageStateError,
+	// while a timeout only reports the last status, so add its reason too.
+	err = ImageTimeoutError(err, lastState)
+
+	if v, ok := outputRaw.(*imagebuilder.Image); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// ImageDeleted waits for an Image to be not found or return Deleted
+func ImageDeleted(ctx context.Context, conn *imagebuilder.Imagebuilder, imageBuildVersionArn string, timeout time.Duration) (*imagebuilder.Image, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
+			imagebuilder.ImageStatusAvailable,
 			imagebuilder.ImageStatusBuilding,
+			imagebuilder.ImageStatusCancelled,
 			imagebuilder.ImageStatusCreating,
+			imagebuilder.ImageStatusDeprecated,
 			imagebuilder.ImageStatusDistributing,
+			imagebuilder.ImageStatusFailed,
 			imagebuilder.ImageStatusIntegrating,
 			imagebuilder.ImageStatusPending,
 			imagebuilder.ImageStatusTesting,
 		},
-		Target:  []string{imagebuilder.ImageStatusAvailable},
-		Refresh: ImageStatus(conn, imageBuildVersionArn),
+		Target:  []string{},
+		Refresh: ImageDeletionStatus(ctx, conn, imageBuildVersionArn),
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if v, ok := outputRaw.(*imagebuilder.Image); ok {
 		return v, err
@@ -0,0 +1,72 @@
+package waiter_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/imagebuilder/waiter"
+)
+
+func TestImageStatusAvailableBackoff(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping simulated image build in short mode")
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Region: aws.String("us-east-1"),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := imagebuilder.New(sess)
+
+	// Simulate a build that stays BUILDING for buildDuration after the first GetImage call.
+	buildDuration := 2 * time.Second
+	fixedPollInterval := 100 * time.Millisecond
+
+	var calls int
+	var start time.Time
+
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		calls++
+
+		if start.IsZero() {
+			start = time.Now()
+		}
+
+		status := imagebuilder.ImageStatusBuilding
+
+		if time.Since(start) >= buildDuration {
+			status = imagebuilder.ImageStatusAvailable
+		}
+
+		r.Data.(*imagebuilder.GetImageOutput).Image = &imagebuilder.Image{
+			State: &imagebuilder.ImageState{
+				Status: aws.String(status),
+			},
+		}
+	})
+
+	image, err := waiter.ImageStatusAvailable(context.Background(), conn, "arn:aws:imagebuilder:us-east-1:123456789012:image/test/1.0.0/1", time.Minute, 0, 0)
+
+	if err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(image.State.Status), imagebuilder.ImageStatusAvailable; got != expected {
+		t.Fatalf("got status %s, expected %s", got, expected)
+	}
+
+	// Polling at a fixed interval would call GetImage once per interval for the whole build.
+	if fixedCalls := int(buildDuration / fixedPollInterval); calls >= fixedCalls/2 {
+		t.Errorf("got %d GetImage calls, expected fewer than half of the %d calls when polling every %s", calls, fixedCalls, fixedPollInterval)
+	}
+}
@@ -278,7 +278,10 @@ func Provider() *schema.Provider {
 			"aws_imagebuilder_image":                         dataSourceAwsImageBuilderImage(),
 			"aws_imagebuilder_image_pipeline":                dataSourceAwsImageBuilderImagePipeline(),
 			"aws_imagebuilder_image_recipe":                  dataSourceAwsImageBuilderImageRecipe(),
+			"aws_imagebuilder_images":                        dataSourceAwsImageBuilderImages(),
 			"aws_imagebuilder_infrastructure_configuration":  datasourceAwsImageBuilderInfrastructureConfiguration(),
+			"aws_imagebuilder_next_recipe_version":           dataSourceAwsImageBuilderNextRecipeVersion(),
+			"aws_imagebuilder_ou_member_accounts":            dataSourceAwsImageBuilderOuMemberAccounts(),
 			"aws_inspector_rules_packages":                   dataSourceAwsInspectorRulesPackages(),
 			"aws_instance":                                   dataSourceAwsInstance(),
 			"aws_instances":                                  dataSourceAwsInstances(),
@@ -11,6 +11,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfimagebuilder "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/imagebuilder"
+)
+
+const (
+	// Maximum length of inline component data. Larger documents must be uploaded to S3 and set in uri.
+	imageBuilderComponentDataMaxLength = 16000
 )
 
 func resourceAwsImageBuilderComponent() *schema.Resource {
@@ -40,7 +46,7 @@ func resourceAwsImageBuilderComponent() *schema.Resource {
 				Computed:     true,
 				ForceNew:     true,
 				ExactlyOneOf: []string{"data", "uri"},
-				ValidateFunc: validation.StringLenBetween(1, 16000),
+				ValidateFunc: validateImageBuilderComponentData,
 			},
 			"date_created": {
 				Type:     schema.TypeString,
@@ -100,6 +106,10 @@ func resourceAwsImageBuilderComponent() *schema.Resource {
 				ForceNew:     true,
 				ExactlyOneOf: []string{"data", "uri"},
 			},
+			"validate_version_increase": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"version": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -157,6 +167,18 @@ func resourceAwsImageBuilderComponentCreate(d *schema.ResourceData, meta interfa
 		input.SemanticVersion = aws.String(v.(string))
 	}
 
+	if d.Get("validate_version_increase").(bool) {
+		latestVersion, err := imageBuilderComponentLatestVersion(conn, aws.StringValue(input.Name))
+
+		if err != nil {
+			return fmt.Errorf("error creating Image Builder Component: %w", err)
+		}
+
+		if err := imageBuilderComponentVersionIncreaseCheck(aws.StringValue(input.SemanticVersion), latestVersion); err != nil {
+			return fmt.Errorf("error creating Image Builder Component: %w", err)
+		}
+	}
+
 	output, err := conn.CreateComponent(input)
 
 	if err != nil {
@@ -253,3 +275,105 @@ func resourceAwsImageBuilderComponentDelete(d *schema.ResourceData, meta interfa
 
 	return nil
 }
+
+// imageBuilderComponentLatestVersion returns the highest semantic version of the components
+// owned by the current account with the given name, or an empty string when there are none.
+func imageBuilderComponentLatestVersion(conn *imagebuilder.Imagebuilder, name string) (string, error) {
+	input := &imagebuilder.ListComponentsInput{
+		ByName: aws.Bool(true),
+		Filters: []*imagebuilder.Filter{
+			{
+				Name:   aws.String("name"),
+				Values: aws.StringSlice([]string{name}),
+			},
+		},
+		Owner: aws.String(imagebuilder.OwnershipSelf),
+	}
+
+	var latestVersion string
+	var compareErr error
+
+	err := conn.ListComponentsPages(input, func(page *imagebuilder.ListComponentsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, componentVersion := range page.ComponentVersionList {
+			if componentVersion == nil || aws.StringValue(componentVersion.Name) != name {
+				continue
+			}
+
+			version := aws.StringValue(componentVersion.Version)
+
+			if latestVersion == "" {
+				latestVersion = version
+				continue
+			}
+
+			result, err := tfimagebuilder.SemanticVersionCompare(version, latestVersion)
+
+			if err != nil {
+				compareErr = fmt.Errorf("error comparing Image Builder Component (%s) version: %w", aws.StringValue(componentVersion.Arn), err)
+				return false
+			}
+
+			if result > 0 {
+				latestVersion = version
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("error listing Image Builder Components: %w", err)
+	}
+
+	if compareErr != nil {
+		return "", compareErr
+	}
+
+	return latestVersion, nil
+}
+
+// imageBuilderComponentVersionIncreaseCheck returns an error when version is not greater than
+// latestVersion, the highest existing version of the component. An empty latestVersion always passes.
+func imageBuilderComponentVersionIncreaseCheck(version string, latestVersion string) error {
+	if latestVersion == "" {
+		return nil
+	}
+
+	result, err := tfimagebuilder.SemanticVersionCompare(version, latestVersion)
+
+	if err != nil {
+		return err
+	}
+
+	if result <= 0 {
+		return fmt.Errorf("version (%s) must be greater than the latest existing version (%s)", version, latestVersion)
+	}
+
+	return nil
+}
+
+// validateImageBuilderComponentData verifies the length of inline component data, pointing
+// users of documents above the limit, such as those read with file(), to the uri argument.
+func validateImageBuilderComponentData(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if len(value) == 0 {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	if len(value) > imageBuilderComponentDataMaxLength {
+		errors = append(errors, fmt.Errorf("%q is %d characters, exceeding the maximum of %d for inline component data: upload the document to S3 and set its S3 URI in \"uri\" instead", k, len(value), imageBuilderComponentDataMaxLength))
+	}
+
+	return
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -102,6 +103,112 @@ func testSweepImageBuilderComponents(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func TestImageBuilderComponentVersionIncreaseCheck(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Version       string
+		LatestVersion string
+		ExpectError   bool
+	}{
+		{
+			TestName: "no existing version",
+			Version:  "1.0.0",
+		},
+		{
+			TestName:      "increasing patch",
+			Version:       "1.0.1",
+			LatestVersion: "1.0.0",
+		},
+		{
+			TestName:      "increasing numerically",
+			Version:       "1.10.0",
+			LatestVersion: "1.9.0",
+		},
+		{
+			TestName:      "equal",
+			Version:       "1.0.0",
+			LatestVersion: "1.0.0",
+			ExpectError:   true,
+		},
+		{
+			TestName:      "decreasing",
+			Version:       "1.9.0",
+			LatestVersion: "1.10.0",
+			ExpectError:   true,
+		},
+		{
+			TestName:      "invalid version",
+			Version:       "1.0",
+			LatestVersion: "1.0.0",
+			ExpectError:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := imageBuilderComponentVersionIncreaseCheck(testCase.Version, testCase.LatestVersion)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidateImageBuilderComponentData(t *testing.T) {
+	testCases := []struct {
+		TestName    string
+		Value       interface{}
+		ExpectError *regexp.Regexp
+	}{
+		{
+			TestName:    "empty",
+			Value:       "",
+			ExpectError: regexp.MustCompile(`must not be empty`),
+		},
+		{
+			TestName: "inline",
+			Value:    "name: test\nschemaVersion: 1.0\n",
+		},
+		{
+			TestName: "maximum length",
+			Value:    strings.Repeat("a", imageBuilderComponentDataMaxLength),
+		},
+		{
+			TestName:    "exceeds maximum length",
+			Value:       strings.Repeat("a", imageBuilderComponentDataMaxLength+1),
+			ExpectError: regexp.MustCompile(`16001 characters, exceeding the maximum of 16000 .+ set its S3 URI in "uri" instead`),
+		},
+		{
+			TestName:    "not a string",
+			Value:       1,
+			ExpectError: regexp.MustCompile(`expected type of "data" to be string`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			_, errs := validateImageBuilderComponentData(testCase.Value, "data")
+
+			if len(errs) == 0 && testCase.ExpectError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectError.String())
+			}
+
+			if len(errs) > 0 && testCase.ExpectError == nil {
+				t.Fatalf("got unexpected errors: %v", errs)
+			}
+
+			if len(errs) > 0 && !testCase.ExpectError.MatchString(errs[0].Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectError.String(), errs[0])
+			}
+		})
+	}
+}
+
 func TestAccAwsImageBuilderComponent_basic(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_imagebuilder_component.test"
@@ -186,6 +293,22 @@ func TestAccAwsImageBuilderComponent_ChangeDescription(t *testing.T) {
 	})
 }
 
+func TestAccAwsImageBuilderComponent_Data_ExceedsMaximumLength(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderComponentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsImageBuilderComponentConfigDataExceedsMaximumLength(rName),
+				ExpectError: regexp.MustCompile(`set its S3 URI in "uri" instead`),
+			},
+		},
+	})
+}
+
 func TestAccAwsImageBuilderComponent_Description(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_imagebuilder_component.test"
@@ -355,6 +478,48 @@ func TestAccAwsImageBuilderComponent_Uri(t *testing.T) {
 	})
 }
 
+func TestAccAwsImageBuilderComponent_ValidateVersionIncrease(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_imagebuilder_component.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderComponentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderComponentConfigValidateVersionIncrease(rName, "1.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderComponentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "validate_version_increase", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validate_version_increase"},
+			},
+			{
+				Config:      testAccAwsImageBuilderComponentConfigValidateVersionIncreaseAdditional(rName, "1.0.0", "1.0.0"),
+				ExpectError: regexp.MustCompile(`must be greater than the latest existing version`),
+			},
+			{
+				Config:      testAccAwsImageBuilderComponentConfigValidateVersionIncreaseAdditional(rName, "1.0.0", "0.9.0"),
+				ExpectError: regexp.MustCompile(`must be greater than the latest existing version`),
+			},
+			{
+				Config: testAccAwsImageBuilderComponentConfigValidateVersionIncreaseAdditional(rName, "1.0.0", "1.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderComponentExists(resourceName),
+					testAccCheckAwsImageBuilderComponentExists("aws_imagebuilder_component.additional"),
+					resource.TestCheckResourceAttr("aws_imagebuilder_component.additional", "version", "1.0.1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAwsImageBuilderComponentDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
 
@@ -433,6 +598,30 @@ resource "aws_imagebuilder_component" "test" {
 `, rName, changeDescription)
 }
 
+func testAccAwsImageBuilderComponentConfigDataExceedsMaximumLength(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_imagebuilder_component" "test" {
+  data = yamlencode({
+    phases = [{
+      name = "build"
+      steps = [{
+        action = "ExecuteBash"
+        inputs = {
+          commands = [for i in range(1000) : "echo 'hello world ${i}'"]
+        }
+        name      = "example"
+        onFailure = "Continue"
+      }]
+    }]
+    schemaVersion = 1.0
+  })
+  name     = %[1]q
+  platform = "Linux"
+  version  = "1.0.0"
+}
+`, rName)
+}
+
 func testAccAwsImageBuilderComponentConfigDescription(rName string, description string) string {
 	return fmt.Sprintf(`
 resource "aws_imagebuilder_component" "test" {
@@ -650,3 +839,42 @@ resource "aws_imagebuilder_component" "test" {
 }
 `, rName)
 }
+
+func testAccAwsImageBuilderComponentConfigValidateVersionIncrease(rName string, version string) string {
+	return fmt.Sprintf(`
+resource "aws_imagebuilder_component" "test" {
+  data = yamlencode({
+    phases = [{
+      name = "build"
+      steps = [{
+        action = "ExecuteBash"
+        inputs = {
+          commands = ["echo 'hello world'"]
+        }
+        name      = "example"
+        onFailure = "Continue"
+      }]
+    }]
+    schemaVersion = 1.0
+  })
+  name                      = %[1]q
+  platform                  = "Linux"
+  validate_version_increase = true
+  version                   = %[2]q
+}
+`, rName, version)
+}
+
+func testAccAwsImageBuilderComponentConfigValidateVersionIncreaseAdditional(rName string, version string, additionalVersion string) string {
+	return composeConfig(
+		testAccAwsImageBuilderComponentConfigValidateVersionIncrease(rName, version),
+		fmt.Sprintf(`
+resource "aws_imagebuilder_component" "additional" {
+  data                      = aws_imagebuilder_component.test.data
+  name                      = aws_imagebuilder_component.test.name
+  platform                  = aws_imagebuilder_component.test.platform
+  validate_version_increase = true
+  version                   = %[1]q
+}
+`, additionalVersion))
+}
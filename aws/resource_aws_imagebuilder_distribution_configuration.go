@@ -1,29 +1,39 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
+// Matches a distribution configuration ARN, the only identifier accepted for import.
+var imageBuilderDistributionConfigurationArnRegexp = regexp.MustCompile(`^arn:aws[^:]*:imagebuilder:[^:]+:(?:\d{12}|aws):distribution-configuration/[a-z0-9-_]+$`)
+
 func resourceAwsImageBuilderDistributionConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsImageBuilderDistributionConfigurationCreate,
-		Read:   resourceAwsImageBuilderDistributionConfigurationRead,
-		Update: resourceAwsImageBuilderDistributionConfigurationUpdate,
-		Delete: resourceAwsImageBuilderDistributionConfigurationDelete,
+		CreateContext: resourceAwsImageBuilderDistributionConfigurationCreate,
+		ReadContext:   resourceAwsImageBuilderDistributionConfigurationRead,
+		UpdateContext: resourceAwsImageBuilderDistributionConfigurationUpdate,
+		DeleteContext: resourceAwsImageBuilderDistributionConfigurationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceAwsImageBuilderDistributionConfigurationImport,
 		},
 
+		CustomizeDiff: resourceAwsImageBuilderDistributionConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -95,6 +105,7 @@ func resourceAwsImageBuilderDistributionConfiguration() *schema.Resource {
 										ValidateFunc: validation.All(
 											validation.StringLenBetween(0, 127),
 											validation.StringMatch(regexp.MustCompile(`^[-_A-Za-z0-9{][-_A-Za-z0-9\s:{}]+[-_A-Za-z0-9}]$`), "must contain only alphanumeric characters, periods, underscores, and hyphens"),
+											validateImageBuilderAmiNameTemplateLength,
 										),
 									},
 									"target_account_ids": {
@@ -131,14 +142,29 @@ func resourceAwsImageBuilderDistributionConfiguration() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 126),
 			},
+			"region_to_ami_name": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"tags": tagsSchema(),
+			"target_account_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"warn_duplicate_ami_names": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
 
-func resourceAwsImageBuilderDistributionConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsImageBuilderDistributionConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).imagebuilderconn
 
+	var diags diag.Diagnostics
+
 	input := &imagebuilder.CreateDistributionConfigurationInput{
 		ClientToken: aws.String(resource.UniqueId()),
 	}
@@ -159,22 +185,26 @@ func resourceAwsImageBuilderDistributionConfigurationCreate(d *schema.ResourceDa
 		input.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().ImagebuilderTags()
 	}
 
-	output, err := conn.CreateDistributionConfiguration(input)
+	if d.Get("warn_duplicate_ami_names").(bool) {
+		diags = append(diags, imageBuilderDistributionConfigurationDuplicateAmiNameWarning(d.Get("distribution").(*schema.Set).List())...)
+	}
+
+	output, err := conn.CreateDistributionConfigurationWithContext(ctx, input)
 
 	if err != nil {
-		return fmt.Errorf("error creating Image Builder Distribution Configuration: %w", err)
+		return append(diags, diag.FromErr(fmt.Errorf("error creating Image Builder Distribution Configuration: %w", err))...)
 	}
 
 	if output == nil {
-		return fmt.Errorf("error creating Image Builder Distribution Configuration: empty response")
+		return append(diags, diag.FromErr(fmt.Errorf("error creating Image Builder Distribution Configuration: empty response"))...)
 	}
 
 	d.SetId(aws.StringValue(output.DistributionConfigurationArn))
 
-	return resourceAwsImageBuilderDistributionConfigurationRead(d, meta)
+	return append(diags, resourceAwsImageBuilderDistributionConfigurationRead(ctx, d, meta)...)
 }
 
-func resourceAwsImageBuilderDistributionConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsImageBuilderDistributionConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).imagebuilderconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -182,7 +212,7 @@ func resourceAwsImageBuilderDistributionConfigurationRead(d *schema.ResourceData
 		DistributionConfigurationArn: aws.String(d.Id()),
 	}
 
-	output, err := conn.GetDistributionConfiguration(input)
+	output, err := conn.GetDistributionConfigurationWithContext(ctx, input)
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Image Builder Distribution Configuration (%s) not found, removing from state", d.Id())
@@ -191,11 +221,11 @@ func resourceAwsImageBuilderDistributionConfigurationRead(d *schema.ResourceData
 	}
 
 	if err != nil {
-		return fmt.Errorf("error getting Image Builder Distribution Configuration (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error getting Image Builder Distribution Configuration (%s): %w", d.Id(), err))
 	}
 
 	if output == nil || output.DistributionConfiguration == nil {
-		return fmt.Errorf("error getting Image Builder Distribution Configuration (%s): empty response", d.Id())
+		return diag.FromErr(fmt.Errorf("error getting Image Builder Distribution Configuration (%s): empty response", d.Id()))
 	}
 
 	distributionConfiguration := output.DistributionConfiguration
@@ -204,16 +234,25 @@ func resourceAwsImageBuilderDistributionConfigurationRead(d *schema.ResourceData
 	d.Set("date_created", distributionConfiguration.DateCreated)
 	d.Set("date_updated", distributionConfiguration.DateUpdated)
 	d.Set("description", distributionConfiguration.Description)
-	d.Set("distribution", flattenImageBuilderDistributions(distributionConfiguration.Distributions))
+	distributions := flattenImageBuilderDistributions(distributionConfiguration.Distributions)
+	d.Set("distribution", distributions)
 	d.Set("name", distributionConfiguration.Name)
+	d.Set("region_to_ami_name", imageBuilderDistributionsRegionToAmiName(distributions))
 	d.Set("tags", keyvaluetags.ImagebuilderKeyValueTags(distributionConfiguration.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map())
+	d.Set("target_account_count", imageBuilderDistributionsTargetAccountCount(distributions))
 
 	return nil
 }
 
-func resourceAwsImageBuilderDistributionConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsImageBuilderDistributionConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).imagebuilderconn
 
+	var diags diag.Diagnostics
+
+	if d.Get("warn_duplicate_ami_names").(bool) && d.HasChanges("distribution", "warn_duplicate_ami_names") {
+		diags = append(diags, imageBuilderDistributionConfigurationDuplicateAmiNameWarning(d.Get("distribution").(*schema.Set).List())...)
+	}
+
 	if d.HasChanges("description", "distribution") {
 		input := &imagebuilder.UpdateDistributionConfigurationInput{
 			DistributionConfigurationArn: aws.String(d.Id()),
@@ -228,10 +267,10 @@ func resourceAwsImageBuilderDistributionConfigurationUpdate(d *schema.ResourceDa
 		}
 
 		log.Printf("[DEBUG] UpdateDistributionConfiguration: %#v", input)
-		_, err := conn.UpdateDistributionConfiguration(input)
+		_, err := conn.UpdateDistributionConfigurationWithContext(ctx, input)
 
 		if err != nil {
-			return fmt.Errorf("error updating Image Builder Distribution Configuration (%s): %w", d.Id(), err)
+			return append(diags, diag.FromErr(fmt.Errorf("error updating Image Builder Distribution Configuration (%s): %w", d.Id(), err))...)
 		}
 	}
 
@@ -239,28 +278,78 @@ func resourceAwsImageBuilderDistributionConfigurationUpdate(d *schema.ResourceDa
 		o, n := d.GetChange("tags")
 
 		if err := keyvaluetags.ImagebuilderUpdateTags(conn, d.Id(), o, n); err != nil {
-			return fmt.Errorf("error updating tags for Image Builder Distribution Configuration (%s): %w", d.Id(), err)
+			return append(diags, diag.FromErr(fmt.Errorf("error updating tags for Image Builder Distribution Configuration (%s): %w", d.Id(), err))...)
 		}
 	}
 
-	return resourceAwsImageBuilderDistributionConfigurationRead(d, meta)
+	return append(diags, resourceAwsImageBuilderDistributionConfigurationRead(ctx, d, meta)...)
 }
 
-func resourceAwsImageBuilderDistributionConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsImageBuilderDistributionConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).imagebuilderconn
 
 	input := &imagebuilder.DeleteDistributionConfigurationInput{
 		DistributionConfigurationArn: aws.String(d.Id()),
 	}
 
-	_, err := conn.DeleteDistributionConfiguration(input)
+	_, err := conn.DeleteDistributionConfigurationWithContext(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting Image Builder Distribution Config (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting Image Builder Distribution Config (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceAwsImageBuilderDistributionConfigurationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if !imageBuilderDistributionConfigurationArnRegexp.MatchString(d.Id()) {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected Image Builder Distribution Configuration ARN (arn:PARTITION:imagebuilder:REGION:ACCOUNT:distribution-configuration/NAME)", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAwsImageBuilderDistributionConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, tfMapRaw := range diff.Get("distribution").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		region, ok := tfMap["region"].(string)
+
+		if !ok || region == "" {
+			continue
+		}
+
+		amiDistributionConfigurations, ok := tfMap["ami_distribution_configuration"].([]interface{})
+
+		if !ok || len(amiDistributionConfigurations) == 0 || amiDistributionConfigurations[0] == nil {
+			continue
+		}
+
+		kmsKeyID, ok := amiDistributionConfigurations[0].(map[string]interface{})["kms_key_id"].(string)
+
+		if !ok || kmsKeyID == "" {
+			continue
+		}
+
+		parsedARN, err := arn.Parse(kmsKeyID)
+
+		// Key identifiers and values not known until apply do not contain a region.
+		if err != nil {
+			log.Printf("[DEBUG] Skipping Image Builder Distribution Configuration KMS key (%s) region check: not an ARN", kmsKeyID)
+			continue
+		}
+
+		if parsedARN.Region != region {
+			return fmt.Errorf("KMS key (%s) in region %s cannot be used for distribution to region %s", kmsKeyID, parsedARN.Region, region)
+		}
 	}
 
 	return nil
@@ -464,3 +553,156 @@ func flattenImageBuilderLaunchPermissionConfiguration(apiObject *imagebuilder.La
 
 	return tfMap
 }
+
+// imageBuilderDistributionsTargetAccountCount returns the number of distinct target accounts
+// across all flattened distributions.
+func imageBuilderDistributionsTargetAccountCount(tfList []interface{}) int {
+	accountIDs := make(map[string]struct{})
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		amiDistributionConfigurations, ok := tfMap["ami_distribution_configuration"].([]interface{})
+
+		if !ok || len(amiDistributionConfigurations) == 0 {
+			continue
+		}
+
+		amiDistributionConfiguration, ok := amiDistributionConfigurations[0].(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		targetAccountIDs, ok := amiDistributionConfiguration["target_account_ids"].([]string)
+
+		if !ok {
+			continue
+		}
+
+		for _, accountID := range targetAccountIDs {
+			accountIDs[accountID] = struct{}{}
+		}
+	}
+
+	return len(accountIDs)
+}
+
+// imageBuilderDistributionsRegionToAmiName returns a map of each flattened distribution region to its AMI name template.
+// Distributions without an AMI name are omitted.
+func imageBuilderDistributionsRegionToAmiName(tfList []interface{}) map[string]string {
+	m := make(map[string]string)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		region, ok := tfMap["region"].(string)
+
+		if !ok || region == "" {
+			continue
+		}
+
+		amiDistributionConfigurations, ok := tfMap["ami_distribution_configuration"].([]interface{})
+
+		if !ok || len(amiDistributionConfigurations) == 0 {
+			continue
+		}
+
+		amiDistributionConfiguration, ok := amiDistributionConfigurations[0].(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if name, ok := amiDistributionConfiguration["name"].(string); ok && name != "" {
+			m[region] = name
+		}
+	}
+
+	return m
+}
+
+// imageBuilderDistributionConfigurationDuplicateAmiNameWarning returns a warning when AMI names are used by
+// more than one distribution. Names can still differ once templates are resolved, so this is not an error.
+func imageBuilderDistributionConfigurationDuplicateAmiNameWarning(tfList []interface{}) diag.Diagnostics {
+	warning := imageBuilderDistributionsDuplicateAmiNameWarning(tfList)
+
+	if warning == "" {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Image Builder Distribution Configuration AMI names are not unique",
+			Detail:   warning + ".",
+		},
+	}
+}
+
+// imageBuilderDistributionsDuplicateAmiNameWarning returns a description of the AMI names, as configured
+// before template resolution, that are used by more than one flattened distribution, or an empty string when there are none.
+func imageBuilderDistributionsDuplicateAmiNameWarning(tfList []interface{}) string {
+	regionsByName := make(map[string][]string)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		amiDistributionConfigurations, ok := tfMap["ami_distribution_configuration"].([]interface{})
+
+		if !ok || len(amiDistributionConfigurations) == 0 {
+			continue
+		}
+
+		amiDistributionConfiguration, ok := amiDistributionConfigurations[0].(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name, ok := amiDistributionConfiguration["name"].(string)
+
+		if !ok || name == "" {
+			continue
+		}
+
+		region, _ := tfMap["region"].(string)
+		regionsByName[name] = append(regionsByName[name], region)
+	}
+
+	var names []string
+
+	for name, regions := range regionsByName {
+		if len(regions) > 1 {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) == 0 {
+		return ""
+	}
+
+	sort.Strings(names)
+
+	var descriptions []string
+
+	for _, name := range names {
+		regions := regionsByName[name]
+		sort.Strings(regions)
+		descriptions = append(descriptions, fmt.Sprintf("%q (regions: %s)", name, strings.Join(regions, ", ")))
+	}
+
+	return fmt.Sprintf("AMI names used by multiple distributions: %s", strings.Join(descriptions, ", "))
+}
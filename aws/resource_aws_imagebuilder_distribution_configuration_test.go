@@ -1,14 +1,19 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -48,10 +53,10 @@ func testSweepImageBuilderDistributionConfigurations(region string) error {
 			d := r.Data(nil)
 			d.SetId(arn)
 
-			err := r.Delete(d, client)
+			diags := r.DeleteContext(context.Background(), d, client)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Image Builder Distribution Configuration (%s): %w", arn, err)
+			if diags.HasError() {
+				sweeperErr := fmt.Errorf("error deleting Image Builder Distribution Configuration (%s): %s", arn, diags[0].Summary)
 				log.Printf("[ERROR] %s", sweeperErr)
 				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
 				continue
@@ -73,6 +78,148 @@ func testSweepImageBuilderDistributionConfigurations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func TestImageBuilderDistributionsDuplicateAmiNameWarning(t *testing.T) {
+	distribution := func(region string, name string) map[string]interface{} {
+		return map[string]interface{}{
+			"ami_distribution_configuration": []interface{}{
+				map[string]interface{}{
+					"name": name,
+				},
+			},
+			"region": region,
+		}
+	}
+
+	testCases := []struct {
+		TestName        string
+		Distributions   []interface{}
+		ExpectedWarning string
+	}{
+		{
+			TestName: "no distributions",
+		},
+		{
+			TestName: "unique names",
+			Distributions: []interface{}{
+				distribution("us-east-1", "example-east-{{ imagebuilder:buildDate }}"),
+				distribution("us-west-2", "example-west-{{ imagebuilder:buildDate }}"),
+			},
+		},
+		{
+			TestName: "no ami distribution configuration",
+			Distributions: []interface{}{
+				map[string]interface{}{"region": "us-east-1"},
+				map[string]interface{}{"region": "us-west-2"},
+			},
+		},
+		{
+			TestName: "duplicate static names",
+			Distributions: []interface{}{
+				distribution("us-west-2", "example"),
+				distribution("us-east-1", "example"),
+				distribution("eu-west-1", "other"),
+			},
+			ExpectedWarning: `AMI names used by multiple distributions: "example" (regions: us-east-1, us-west-2)`,
+		},
+		{
+			TestName: "duplicate template names",
+			Distributions: []interface{}{
+				distribution("us-east-1", "example-{{ imagebuilder:buildDate }}"),
+				distribution("us-west-2", "example-{{ imagebuilder:buildDate }}"),
+			},
+			ExpectedWarning: `AMI names used by multiple distributions: "example-{{ imagebuilder:buildDate }}" (regions: us-east-1, us-west-2)`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := imageBuilderDistributionsDuplicateAmiNameWarning(testCase.Distributions)
+
+			if got != testCase.ExpectedWarning {
+				t.Errorf("got warning %q, expected %q", got, testCase.ExpectedWarning)
+			}
+
+			diags := imageBuilderDistributionConfigurationDuplicateAmiNameWarning(testCase.Distributions)
+
+			if testCase.ExpectedWarning == "" && len(diags) != 0 {
+				t.Errorf("got unexpected diagnostics: %v", diags)
+			}
+
+			if testCase.ExpectedWarning != "" && (len(diags) != 1 || diags[0].Severity != diag.Warning) {
+				t.Errorf("expected one warning diagnostic, got: %v", diags)
+			}
+		})
+	}
+}
+
+func TestImageBuilderDistributionsRegionToAmiName(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Distributions []interface{}
+		Expected      map[string]string
+	}{
+		{
+			TestName: "no distributions",
+			Expected: map[string]string{},
+		},
+		{
+			TestName: "ami names",
+			Distributions: []interface{}{
+				map[string]interface{}{
+					"ami_distribution_configuration": []interface{}{
+						map[string]interface{}{"name": "example-east-{{ imagebuilder:buildDate }}"},
+					},
+					"region": "us-east-1",
+				},
+				map[string]interface{}{
+					"ami_distribution_configuration": []interface{}{
+						map[string]interface{}{"name": "example-west-{{ imagebuilder:buildDate }}"},
+					},
+					"region": "us-west-2",
+				},
+			},
+			Expected: map[string]string{
+				"us-east-1": "example-east-{{ imagebuilder:buildDate }}",
+				"us-west-2": "example-west-{{ imagebuilder:buildDate }}",
+			},
+		},
+		{
+			TestName: "distributions without ami name",
+			Distributions: []interface{}{
+				map[string]interface{}{
+					"ami_distribution_configuration": []interface{}{
+						map[string]interface{}{"name": "example"},
+					},
+					"region": "us-east-1",
+				},
+				map[string]interface{}{
+					"ami_distribution_configuration": []interface{}{
+						map[string]interface{}{"description": "example"},
+					},
+					"region": "us-west-2",
+				},
+				map[string]interface{}{
+					"license_configuration_arns": []interface{}{},
+					"region":                     "eu-west-1",
+				},
+			},
+			Expected: map[string]string{
+				"us-east-1": "example",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := imageBuilderDistributionsRegionToAmiName(testCase.Distributions)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestAccAwsImageBuilderDistributionConfiguration_basic(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_imagebuilder_distribution_configuration.test"
@@ -103,6 +250,43 @@ func TestAccAwsImageBuilderDistributionConfiguration_basic(t *testing.T) {
 	})
 }
 
+func TestAccAwsImageBuilderDistributionConfiguration_Import(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_imagebuilder_distribution_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsImageBuilderDistributionConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderDistributionConfigurationConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderDistributionConfigurationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsImageBuilderDistributionConfigurationImportStateIdFunc(resourceName, ""),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsImageBuilderDistributionConfigurationImportStateIdFunc(resourceName, "image-recipe"),
+				ExpectError:       regexp.MustCompile(`expected Image Builder Distribution Configuration ARN`),
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: rName,
+				ExpectError:   regexp.MustCompile(`expected Image Builder Distribution Configuration ARN`),
+			},
+		},
+	})
+}
+
 func TestAccAwsImageBuilderDistributionConfiguration_disappears(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_imagebuilder_distribution_configuration.test"
@@ -185,6 +369,37 @@ func TestAccAwsImageBuilderDistributionConfiguration_Distribution(t *testing.T)
 	})
 }
 
+func TestAccAwsImageBuilderDistributionConfiguration_WarnDuplicateAmiNames(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_imagebuilder_distribution_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionPreCheck(t, 2)
+		},
+		ProviderFactories: testAccProviderFactoriesMultipleRegion(nil, 2),
+		CheckDestroy:      testAccCheckAwsImageBuilderDistributionConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Duplicate AMI names are reported as a warning and do not prevent creation.
+				Config: testAccAwsImageBuilderDistributionConfigurationConfigWarnDuplicateAmiNames(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderDistributionConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "distribution.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "warn_duplicate_ami_names", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"warn_duplicate_ami_names"},
+			},
+		},
+	})
+}
+
 func TestAccAwsImageBuilderDistributionConfiguration_Distribution_AmiDistributionConfiguration_AmiTags(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_imagebuilder_distribution_configuration.test"
@@ -265,6 +480,17 @@ func TestAccAwsImageBuilderDistributionConfiguration_Distribution_AmiDistributio
 					}),
 				),
 			},
+			{
+				Config: testAccAwsImageBuilderDistributionConfigurationConfigDistributionAmiDistributionConfigurationDescription(rName, "description {{ imagebuilder:buildDate }}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderDistributionConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "distribution.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "distribution.*", map[string]string{
+						"ami_distribution_configuration.#":             "1",
+						"ami_distribution_configuration.0.description": "description {{ imagebuilder:buildDate }}",
+					}),
+				),
+			},
 		},
 	})
 }
@@ -306,6 +532,33 @@ func TestAccAwsImageBuilderDistributionConfiguration_Distribution_AmiDistributio
 	})
 }
 
+func TestAccAwsImageBuilderDistributionConfiguration_Distribution_AmiDistributionConfiguration_KmsKeyId_Region(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_imagebuilder_distribution_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionPreCheck(t, 2)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsImageBuilderDistributionConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsImageBuilderDistributionConfigurationConfigDistributionAmiDistributionConfigurationKmsKeyIdRegion(rName, testAccGetAlternateRegion()),
+				ExpectError: regexp.MustCompile(`cannot be used for distribution to region`),
+			},
+			{
+				Config: testAccAwsImageBuilderDistributionConfigurationConfigDistributionAmiDistributionConfigurationKmsKeyIdRegion(rName, testAccGetRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderDistributionConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "distribution.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAwsImageBuilderDistributionConfiguration_Distribution_AmiDistributionConfiguration_LaunchPermission_UserGroups(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_imagebuilder_distribution_configuration.test"
@@ -385,6 +638,8 @@ func TestAccAwsImageBuilderDistributionConfiguration_Distribution_AmiDistributio
 						"ami_distribution_configuration.#":      "1",
 						"ami_distribution_configuration.0.name": "name1-{{ imagebuilder:buildDate }}",
 					}),
+					resource.TestCheckResourceAttr(resourceName, "region_to_ami_name.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("region_to_ami_name.%s", testAccGetRegion()), "name1-{{ imagebuilder:buildDate }}"),
 				),
 			},
 			{
@@ -402,6 +657,7 @@ func TestAccAwsImageBuilderDistributionConfiguration_Distribution_AmiDistributio
 						"ami_distribution_configuration.#":      "1",
 						"ami_distribution_configuration.0.name": "name2-{{ imagebuilder:buildDate }}",
 					}),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("region_to_ami_name.%s", testAccGetRegion()), "name2-{{ imagebuilder:buildDate }}"),
 				),
 			},
 		},
@@ -443,6 +699,61 @@ func TestAccAwsImageBuilderDistributionConfiguration_Distribution_AmiDistributio
 	})
 }
 
+func TestAccAwsImageBuilderDistributionConfiguration_Distribution_AmiDistributionConfiguration_TargetAccountIds_Duplicate(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_imagebuilder_distribution_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsImageBuilderDistributionConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderDistributionConfigurationConfigDistributionAmiDistributionConfigurationTargetAccountIdsDuplicate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderDistributionConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "distribution.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "distribution.*.ami_distribution_configuration.0.target_account_ids.*", "111111111111"),
+					resource.TestCheckResourceAttr(resourceName, "target_account_count", "1"),
+				),
+			},
+			{
+				Config:   testAccAwsImageBuilderDistributionConfigurationConfigDistributionAmiDistributionConfigurationTargetAccountIdsDuplicate(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderDistributionConfiguration_TargetAccountCount(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_imagebuilder_distribution_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionPreCheck(t, 2)
+		},
+		ProviderFactories: testAccProviderFactoriesMultipleRegion(nil, 2),
+		CheckDestroy:      testAccCheckAwsImageBuilderDistributionConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderDistributionConfigurationConfigTargetAccountCount(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderDistributionConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "distribution.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "target_account_count", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAwsImageBuilderDistributionConfiguration_Distribution_LicenseConfigurationArns(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	licenseConfigurationResourceName := "aws_licensemanager_license_configuration.test"
@@ -553,6 +864,26 @@ func testAccCheckAwsImageBuilderDistributionConfigurationDestroy(s *terraform.St
 	return nil
 }
 
+// testAccAwsImageBuilderDistributionConfigurationImportStateIdFunc returns the resource ARN,
+// with its resource type replaced when resourceType is not empty.
+func testAccAwsImageBuilderDistributionConfigurationImportStateIdFunc(resourceName string, resourceType string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		id := rs.Primary.Attributes["arn"]
+
+		if resourceType != "" {
+			id = strings.Replace(id, ":distribution-configuration/", fmt.Sprintf(":%s/", resourceType), 1)
+		}
+
+		return id, nil
+	}
+}
+
 func testAccCheckAwsImageBuilderDistributionConfigurationExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -627,6 +958,71 @@ resource "aws_imagebuilder_distribution_configuration" "test" {
 `, rName))
 }
 
+func testAccAwsImageBuilderDistributionConfigurationConfigWarnDuplicateAmiNames(rName string) string {
+	return composeConfig(
+		testAccMultipleRegionProviderConfig(2),
+		fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_region" "alternate" {
+  provider = awsalternate
+}
+
+resource "aws_imagebuilder_distribution_configuration" "test" {
+  name                     = %[1]q
+  warn_duplicate_ami_names = true
+
+  distribution {
+    ami_distribution_configuration {
+      name = %[1]q
+    }
+
+    region = data.aws_region.current.name
+  }
+
+  distribution {
+    ami_distribution_configuration {
+      name = %[1]q
+    }
+
+    region = data.aws_region.alternate.name
+  }
+}
+`, rName))
+}
+
+func testAccAwsImageBuilderDistributionConfigurationConfigTargetAccountCount(rName string) string {
+	return composeConfig(
+		testAccMultipleRegionProviderConfig(2),
+		fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_region" "alternate" {
+  provider = awsalternate
+}
+
+resource "aws_imagebuilder_distribution_configuration" "test" {
+  name = %[1]q
+
+  distribution {
+    ami_distribution_configuration {
+      target_account_ids = ["111111111111", "222222222222"]
+    }
+
+    region = data.aws_region.current.name
+  }
+
+  distribution {
+    ami_distribution_configuration {
+      target_account_ids = ["222222222222", "333333333333"]
+    }
+
+    region = data.aws_region.alternate.name
+  }
+}
+`, rName))
+}
+
 func testAccAwsImageBuilderDistributionConfigurationConfigDistributionAmiDistributionConfigurationAmiTags(rName string, amiTagKey string, amiTagValue string) string {
 	return fmt.Sprintf(`
 data "aws_region" "current" {}
@@ -665,6 +1061,28 @@ resource "aws_imagebuilder_distribution_configuration" "test" {
 `, rName, description)
 }
 
+func testAccAwsImageBuilderDistributionConfigurationConfigDistributionAmiDistributionConfigurationKmsKeyIdRegion(rName string, kmsKeyRegion string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_imagebuilder_distribution_configuration" "test" {
+  name = %[1]q
+
+  distribution {
+    ami_distribution_configuration {
+      kms_key_id = "arn:${data.aws_partition.current.partition}:kms:%[2]s:${data.aws_caller_identity.current.account_id}:key/00000000-0000-0000-0000-000000000000"
+    }
+
+    region = data.aws_region.current.name
+  }
+}
+`, rName, kmsKeyRegion)
+}
+
 func testAccAwsImageBuilderDistributionConfigurationConfigDistributionAmiDistributionConfigurationKmsKeyId1(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -785,6 +1203,24 @@ resource "aws_imagebuilder_distribution_configuration" "test" {
 `, rName, targetAccountId)
 }
 
+func testAccAwsImageBuilderDistributionConfigurationConfigDistributionAmiDistributionConfigurationTargetAccountIdsDuplicate(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_imagebuilder_distribution_configuration" "test" {
+  name = %[1]q
+
+  distribution {
+    ami_distribution_configuration {
+      target_account_ids = ["111111111111", "111111111111"]
+    }
+
+    region = data.aws_region.current.name
+  }
+}
+`, rName)
+}
+
 func testAccAwsImageBuilderDistributionConfigurationConfigDistributionLicenseConfigurationArns1(rName string) string {
 	return fmt.Sprintf(`
 data "aws_region" "current" {}
@@ -1,39 +1,143 @@
 package aws
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	tfimagebuilder "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/imagebuilder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/imagebuilder/waiter"
 )
 
+const (
+	// Image test timeouts above this are considered long enough that a lingering build instance is costly.
+	imageBuilderImageTestsLongTimeoutMinutes = 120
+
+	// Source of the custom EventBridge events sent when an image build completes.
+	imageBuilderImageEventSource = "terraform.imagebuilder"
+
+	// Tag key applied to output AMIs with the semantic version of the image recipe that produced them.
+	imageBuilderImageAmiTagKeySourceRecipeVersion = "SourceRecipeVersion"
+)
+
+// Matches a component build version ARN, as reported in Image build failure reasons.
+var imageBuilderImageFailedComponentRegexp = regexp.MustCompile(`arn:aws[^:]*:imagebuilder:[^:\s]+:(?:\d{12}|aws):component/[a-z0-9-_]+/\d+\.\d+\.\d+(?:/\d+)?`)
+
 func resourceAwsImageBuilderImage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsImageBuilderImageCreate,
-		Read:   resourceAwsImageBuilderImageRead,
-		Update: resourceAwsImageBuilderImageUpdate,
-		Delete: resourceAwsImageBuilderImageDelete,
+		CreateContext: resourceAwsImageBuilderImageCreate,
+		ReadContext:   resourceAwsImageBuilderImageRead,
+		UpdateContext: resourceAwsImageBuilderImageUpdate,
+		DeleteContext: resourceAwsImageBuilderImageDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
+			"ami_architectures": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ami_footprint": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ami_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"snapshot_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_volume_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ami_kms_key_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"image": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ami_snapshot_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"image": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"snapshot_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"build_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"build_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"building_warning_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"check_instance_termination": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -44,18 +148,68 @@ func resourceAwsImageBuilderImage() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^arn:aws[^:]*:imagebuilder:[^:]+:(?:\d{12}|aws):distribution-configuration/[a-z0-9-_]+$`), "valid distribution configuration ARN must be provided"),
 			},
+			"distribution_configuration_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"emit_eventbridge_event": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"detail_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "Image Builder Image Available",
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"event_bus_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "default",
+							ValidateFunc: validation.StringLenBetween(1, 1600),
+						},
+					},
+				},
+			},
+			"effective_ami_tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"enhanced_image_metadata_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				ForceNew: true,
 				Default:  true,
 			},
+			"expected_duration_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"failed_component": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"image_recipe_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^arn:aws[^:]*:imagebuilder:[^:]+:(?:\d{12}|aws):image-recipe/[a-z0-9-_]+/\d+\.\d+\.\d+$`), "valid image recipe ARN must be provided"),
 			},
+			"image_recipe_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"image_tests_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -86,6 +240,18 @@ func resourceAwsImageBuilderImage() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^arn:aws[^:]*:imagebuilder:[^:]+:(?:\d{12}|aws):infrastructure-configuration/[a-z0-9-_]+$`), "valid infrastructure configuration ARN must be provided"),
 			},
+			"infrastructure_configuration_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"logs_encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"logs_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -127,6 +293,43 @@ func resourceAwsImageBuilderImage() *schema.Resource {
 								},
 							},
 						},
+						"containers": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"image_uris": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"images": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"repository": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"tag": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"uri": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -134,7 +337,81 @@ func resourceAwsImageBuilderImage() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"recipe_arn_base": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recipe_components": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resolve_ami_architectures": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"resolve_ami_footprint": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"resolve_ami_kms_key_ids": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"resolve_ami_snapshot_ids": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"resolve_effective_ami_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"resolve_logs_encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"semantic_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_pipeline_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tag_amis_with_recipe_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
 			"tags": tagsSchema(),
+			"target_repositories": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"repository_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"total_ami_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total_container_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -143,7 +420,7 @@ func resourceAwsImageBuilderImage() *schema.Resource {
 	}
 }
 
-func resourceAwsImageBuilderImageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsImageBuilderImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).imagebuilderconn
 
 	input := &imagebuilder.CreateImageInput{
@@ -171,33 +448,114 @@ func resourceAwsImageBuilderImageCreate(d *schema.ResourceData, meta interface{}
 		input.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().ImagebuilderTags()
 	}
 
-	output, err := conn.CreateImage(input)
+	var diags diag.Diagnostics
+
+	accountMismatch, err := tfimagebuilder.ARNsPartitionAndAccountCheck(
+		aws.StringValue(input.ImageRecipeArn),
+		aws.StringValue(input.InfrastructureConfigurationArn),
+		aws.StringValue(input.DistributionConfigurationArn),
+	)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Image Builder Image: %w", err))
+	}
+
+	if accountMismatch {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Image Builder Image inputs reference multiple AWS accounts",
+			Detail:   "The image recipe, infrastructure configuration, and distribution configuration ARNs are not all in the same AWS account. The image build fails unless the resources are shared with the current account.",
+		})
+	}
+
+	if d.Get("check_instance_termination").(bool) && imageBuilderImageTestsTimeoutMinutes(input.ImageTestsConfiguration) > imageBuilderImageTestsLongTimeoutMinutes {
+		infrastructureConfigurationOutput, err := conn.GetInfrastructureConfigurationWithContext(ctx, &imagebuilder.GetInfrastructureConfigurationInput{
+			InfrastructureConfigurationArn: input.InfrastructureConfigurationArn,
+		})
+
+		if err != nil {
+			log.Printf("[WARN] Unable to read Image Builder Infrastructure Configuration (%s) instance termination behavior: %s", aws.StringValue(input.InfrastructureConfigurationArn), err)
+		} else if infrastructureConfigurationOutput != nil {
+			diags = append(diags, imageBuilderImageTestsTerminationWarning(input.ImageTestsConfiguration, infrastructureConfigurationOutput.InfrastructureConfiguration)...)
+		}
+	}
+
+	output, err := conn.CreateImageWithContext(ctx, input)
 
 	if err != nil {
-		return fmt.Errorf("error creating Image Builder Image: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating Image Builder Image: %w", err))
 	}
 
 	if output == nil {
-		return fmt.Errorf("error creating Image Builder Image: empty response")
+		return diag.FromErr(fmt.Errorf("error creating Image Builder Image: empty response"))
 	}
 
 	d.SetId(aws.StringValue(output.ImageBuildVersionArn))
 
-	if _, err := waiter.ImageStatusAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return fmt.Errorf("error waiting for Image Builder Image (%s) to become available: %w", d.Id(), err)
+	image, err := waiter.ImageStatusAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate), time.Duration(d.Get("building_warning_minutes").(int))*time.Minute, time.Duration(d.Get("expected_duration_minutes").(int))*time.Minute)
+
+	if err != nil {
+		if imageBuilderImageCreateInterrupted(ctx) {
+			imageBuilderImageCancelCreation(conn, d.Id())
+		}
+
+		// The failed build remains in state as tainted, so record why it failed for later inspection.
+		if image != nil {
+			failureReason := imageBuilderImageFailureReason(image.State)
+			d.Set("failure_reason", failureReason)
+			d.Set("failed_component", imageBuilderImageFailedComponent(failureReason))
+		}
+
+		return append(diags, diag.FromErr(fmt.Errorf("error waiting for Image Builder Image (%s) to become available: %w", d.Id(), err))...)
+	}
+
+	diags = append(diags, imageBuilderImageEnhancedImageMetadataEnabledWarning(input.EnhancedImageMetadataEnabled, image)...)
+
+	// The image includes the distribution configuration used to build it, so no separate lookup is needed.
+	if image != nil {
+		diags = append(diags, imageBuilderImageBuildRegionDistributionWarning(meta.(*AWSClient).region, image.DistributionConfiguration)...)
+	}
+
+	if d.Get("tag_amis_with_recipe_version").(bool) && image != nil {
+		recipeVersion, err := tfimagebuilder.RecipeARNToSemanticVersion(aws.StringValue(input.ImageRecipeArn))
+
+		if err != nil {
+			return append(diags, diag.FromErr(fmt.Errorf("error parsing Image Builder Image (%s) recipe version: %w", d.Id(), err))...)
+		}
+
+		if err := imageBuilderImageTagOutputAmis(meta.(*AWSClient).ec2conn, meta.(*AWSClient).region, meta.(*AWSClient).accountid, image.OutputResources, map[string]string{imageBuilderImageAmiTagKeySourceRecipeVersion: recipeVersion}); err != nil {
+			return append(diags, diag.FromErr(fmt.Errorf("error tagging Image Builder Image (%s) output AMIs: %w", d.Id(), err))...)
+		}
+	}
+
+	if v, ok := d.GetOk("emit_eventbridge_event"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil && image != nil {
+		entry, err := expandImageBuilderImageEventBridgeEntry(v.([]interface{})[0].(map[string]interface{}), image)
+
+		if err != nil {
+			return append(diags, diag.FromErr(fmt.Errorf("error building Image Builder Image (%s) EventBridge event: %w", d.Id(), err))...)
+		}
+
+		// The image is already built, so failing to send the event is reported without failing the resource.
+		if err := imageBuilderImagePutEvent(ctx, meta.(*AWSClient).cloudwatcheventsconn, entry); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Unable to send Image Builder Image EventBridge event",
+				Detail:   fmt.Sprintf("Image Builder Image (%s) is available, but sending the EventBridge event failed: %s", d.Id(), err),
+			})
+		}
 	}
 
-	return resourceAwsImageBuilderImageRead(d, meta)
+	return append(diags, resourceAwsImageBuilderImageRead(ctx, d, meta)...)
 }
 
-func resourceAwsImageBuilderImageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsImageBuilderImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).imagebuilderconn
 
 	input := &imagebuilder.GetImageInput{
 		ImageBuildVersionArn: aws.String(d.Id()),
 	}
 
-	output, err := conn.GetImage(input)
+	output, err := conn.GetImageWithContext(ctx, input)
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Image Builder Image (%s) not found, removing from state", d.Id())
@@ -206,28 +564,79 @@ func resourceAwsImageBuilderImageRead(d *schema.ResourceData, meta interface{})
 	}
 
 	if err != nil {
-		return fmt.Errorf("error getting Image Builder Image (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error getting Image Builder Image (%s): %w", d.Id(), err))
 	}
 
 	if output == nil || output.Image == nil {
-		return fmt.Errorf("error getting Image Builder Image (%s): empty response", d.Id())
+		return diag.FromErr(fmt.Errorf("error getting Image Builder Image (%s): empty response", d.Id()))
 	}
 
 	image := output.Image
 
 	d.Set("arn", image.Arn)
+
+	buildRegion, err := tfimagebuilder.ImageARNToRegion(aws.StringValue(image.Arn))
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing Image Builder Image (%s) ARN: %w", d.Id(), err))
+	}
+
+	d.Set("build_region", buildRegion)
 	d.Set("date_created", image.DateCreated)
 
 	if image.DistributionConfiguration != nil {
 		d.Set("distribution_configuration_arn", image.DistributionConfiguration.Arn)
+		d.Set("distribution_configuration_name", image.DistributionConfiguration.Name)
+
+		targetRepositories, err := imageBuilderImageTargetRepositories(ctx, conn, image.DistributionConfiguration)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading Image Builder Image (%s) target repositories: %w", d.Id(), err))
+		}
+
+		if err := d.Set("target_repositories", targetRepositories); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting target_repositories: %w", err))
+		}
+	} else {
+		d.Set("distribution_configuration_name", nil)
+		d.Set("target_repositories", nil)
 	}
 
-	d.Set("enhanced_image_metadata_enabled", image.EnhancedImageMetadataEnabled)
+	// Keep the prior value when the API omits the setting, since setting null would show a
+	// difference against the schema default and force a new image.
+	if v := image.EnhancedImageMetadataEnabled; v != nil {
+		d.Set("enhanced_image_metadata_enabled", aws.BoolValue(v))
+	} else {
+		d.Set("enhanced_image_metadata_enabled", d.Get("enhanced_image_metadata_enabled").(bool))
+	}
+
+	failureReason := imageBuilderImageFailureReason(image.State)
+	d.Set("failure_reason", failureReason)
+	d.Set("failed_component", imageBuilderImageFailedComponent(failureReason))
 
 	if image.ImageRecipe != nil {
 		d.Set("image_recipe_arn", image.ImageRecipe.Arn)
+		d.Set("image_recipe_name", image.ImageRecipe.Name)
+		d.Set("recipe_components", flattenImageBuilderRecipeComponentArns(image.ImageRecipe.Components))
+	} else {
+		d.Set("image_recipe_name", nil)
+		d.Set("recipe_components", nil)
+	}
+
+	var recipeArnBase string
+
+	if image.ImageRecipe != nil {
+		recipeArnBase, err = tfimagebuilder.RecipeARNToUnversionedARN(aws.StringValue(image.ImageRecipe.Arn))
+	} else if image.ContainerRecipe != nil {
+		recipeArnBase, err = tfimagebuilder.RecipeARNToUnversionedARN(aws.StringValue(image.ContainerRecipe.Arn))
 	}
 
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing Image Builder Image (%s) recipe ARN: %w", d.Id(), err))
+	}
+
+	d.Set("recipe_arn_base", recipeArnBase)
+
 	if image.ImageTestsConfiguration != nil {
 		d.Set("image_tests_configuration", []interface{}{flattenImageBuilderImageTestsConfiguration(image.ImageTestsConfiguration)})
 	} else {
@@ -236,67 +645,757 @@ func resourceAwsImageBuilderImageRead(d *schema.ResourceData, meta interface{})
 
 	if image.InfrastructureConfiguration != nil {
 		d.Set("infrastructure_configuration_arn", image.InfrastructureConfiguration.Arn)
+		d.Set("infrastructure_configuration_name", image.InfrastructureConfiguration.Name)
+	} else {
+		d.Set("infrastructure_configuration_name", nil)
 	}
 
+	d.Set("logs_uri", imageBuilderImageLogsUri(image))
 	d.Set("name", image.Name)
 	d.Set("platform", image.Platform)
-	d.Set("os_version", image.OsVersion)
+
+	osVersion := aws.StringValue(image.OsVersion)
+
+	// The OS version of completed builds is occasionally empty, so fall back to the parent image.
+	if osVersion == "" && image.State != nil && aws.StringValue(image.State.Status) == imagebuilder.ImageStatusAvailable && image.ImageRecipe != nil {
+		osVersion = imageBuilderImageParentOsVersion(ctx, conn, meta.(*AWSClient).accountid, aws.StringValue(image.ImageRecipe.ParentImage))
+	}
+
+	d.Set("os_version", osVersion)
 
 	if image.OutputResources != nil {
 		d.Set("output_resources", []interface{}{flattenImageBuilderOutputResources(image.OutputResources)})
+		d.Set("total_ami_count", len(image.OutputResources.Amis))
+		d.Set("total_container_count", len(image.OutputResources.Containers))
 	} else {
 		d.Set("output_resources", nil)
+		d.Set("total_ami_count", 0)
+		d.Set("total_container_count", 0)
 	}
 
-	d.Set("tags", keyvaluetags.ImagebuilderKeyValueTags(image.Tags).IgnoreAws().IgnoreConfig(meta.(*AWSClient).IgnoreTagsConfig).Map())
-	d.Set("version", image.Version)
+	var ec2Images []*ec2.Image
 
-	return nil
-}
+	if d.Get("resolve_ami_architectures").(bool) || d.Get("resolve_ami_footprint").(bool) || d.Get("resolve_ami_kms_key_ids").(bool) || d.Get("resolve_ami_snapshot_ids").(bool) {
+		ec2Images, err = imageBuilderImageDescribeOutputAmis(meta.(*AWSClient).ec2conn, meta.(*AWSClient).region, image.OutputResources)
 
-func resourceAwsImageBuilderImageUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading Image Builder Image (%s) output AMIs: %w", d.Id(), err))
+		}
+	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.Get("resolve_ami_architectures").(bool) {
+		if err := d.Set("ami_architectures", flattenImageBuilderAmiArchitectures(ec2Images)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting ami_architectures: %w", err))
+		}
+	} else {
+		d.Set("ami_architectures", nil)
+	}
 
-		if err := keyvaluetags.ImagebuilderUpdateTags(conn, d.Id(), o, n); err != nil {
-			return fmt.Errorf("error updating tags for Image Builder Image (%s): %w", d.Id(), err)
+	if d.Get("resolve_ami_footprint").(bool) {
+		if err := d.Set("ami_footprint", []interface{}{flattenImageBuilderAmiFootprint(ec2Images)}); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting ami_footprint: %w", err))
 		}
+	} else {
+		d.Set("ami_footprint", nil)
 	}
 
-	return resourceAwsImageBuilderImageRead(d, meta)
-}
+	if d.Get("resolve_ami_kms_key_ids").(bool) {
+		amiKmsKeyIds, err := imageBuilderImageAmiKmsKeyIds(meta.(*AWSClient).ec2conn, ec2Images)
 
-func resourceAwsImageBuilderImageDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading Image Builder Image (%s) output AMI KMS keys: %w", d.Id(), err))
+		}
 
-	input := &imagebuilder.DeleteImageInput{
-		ImageBuildVersionArn: aws.String(d.Id()),
+		if err := d.Set("ami_kms_key_ids", amiKmsKeyIds); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting ami_kms_key_ids: %w", err))
+		}
+	} else {
+		d.Set("ami_kms_key_ids", nil)
 	}
 
-	_, err := conn.DeleteImage(input)
-
-	if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
-		return nil
+	if d.Get("resolve_ami_snapshot_ids").(bool) {
+		if err := d.Set("ami_snapshot_ids", flattenImageBuilderAmiSnapshotIds(ec2Images)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting ami_snapshot_ids: %w", err))
+		}
+	} else {
+		d.Set("ami_snapshot_ids", nil)
 	}
 
-	if err != nil {
-		return fmt.Errorf("error deleting Image Builder Image (%s): %w", d.Id(), err)
-	}
+	if d.Get("resolve_effective_ami_tags").(bool) {
+		effectiveAmiTags, err := imageBuilderImageEffectiveAmiTags(meta.(*AWSClient).ec2conn, meta.(*AWSClient).region, meta.(*AWSClient).accountid, image.OutputResources)
 
-	return nil
-}
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading Image Builder Image (%s) output AMI tags: %w", d.Id(), err))
+		}
 
-func flattenImageBuilderOutputResources(apiObject *imagebuilder.OutputResources) map[string]interface{} {
-	if apiObject == nil {
-		return nil
+		if err := d.Set("effective_ami_tags", effectiveAmiTags); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting effective_ami_tags: %w", err))
+		}
+	} else {
+		d.Set("effective_ami_tags", nil)
 	}
 
-	tfMap := map[string]interface{}{}
+	if d.Get("resolve_logs_encrypted").(bool) {
+		logsEncrypted, err := imageBuilderImageLogsEncrypted(meta.(*AWSClient).s3conn, image.InfrastructureConfiguration)
 
-	if v := apiObject.Amis; v != nil {
-		tfMap["amis"] = flattenImageBuilderAmis(v)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading Image Builder Image (%s) log bucket encryption: %w", d.Id(), err))
+		}
+
+		d.Set("logs_encrypted", logsEncrypted)
+	} else {
+		d.Set("logs_encrypted", nil)
+	}
+
+	d.Set("source_pipeline_arn", image.SourcePipelineArn)
+	d.Set("tags", keyvaluetags.ImagebuilderKeyValueTags(image.Tags).IgnoreAws().IgnoreConfig(meta.(*AWSClient).IgnoreTagsConfig).Map())
+	d.Set("version", image.Version)
+
+	// An unexpected version format only affects these convenience attributes, so it does not fail the read.
+	if semanticVersion, buildNumber, err := tfimagebuilder.ImageVersionParse(aws.StringValue(image.Version)); err != nil {
+		log.Printf("[WARN] Unable to parse Image Builder Image (%s) version: %s", d.Id(), err)
+		d.Set("build_number", nil)
+		d.Set("semantic_version", nil)
+	} else {
+		d.Set("build_number", buildNumber)
+		d.Set("semantic_version", semanticVersion)
+	}
+
+	return nil
+}
+
+func resourceAwsImageBuilderImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.ImagebuilderUpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating tags for Image Builder Image (%s): %w", d.Id(), err))
+		}
+	}
+
+	return resourceAwsImageBuilderImageRead(ctx, d, meta)
+}
+
+func resourceAwsImageBuilderImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).imagebuilderconn
+
+	input := &imagebuilder.DeleteImageInput{
+		ImageBuildVersionArn: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteImageWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Image Builder Image (%s): %w", d.Id(), err))
+	}
+
+	if _, err := waiter.ImageDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for Image Builder Image (%s) to be deleted: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+// imageBuilderImageCreateInterrupted returns whether the create context was cancelled, e.g. by an interrupted apply.
+// The context also carries the create timeout, which is reported by the waiter and does not cancel the build.
+func imageBuilderImageCreateInterrupted(ctx context.Context) bool {
+	return errors.Is(ctx.Err(), context.Canceled)
+}
+
+// imageBuilderImageCancelCreation attempts to stop an in-progress build, such as when
+// Terraform is interrupted while waiting for the build to complete.
+// The request context is already cancelled at that point, so it is not used here.
+func imageBuilderImageCancelCreation(conn *imagebuilder.Imagebuilder, imageBuildVersionArn string) {
+	input := &imagebuilder.CancelImageCreationInput{
+		ClientToken:          aws.String(resource.UniqueId()),
+		ImageBuildVersionArn: aws.String(imageBuildVersionArn),
+	}
+
+	log.Printf("[DEBUG] Cancelling Image Builder Image (%s) creation", imageBuildVersionArn)
+	_, err := conn.CancelImageCreation(input)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to cancel Image Builder Image (%s) creation: %s", imageBuildVersionArn, err)
+	}
+}
+
+// imageBuilderImageDescribeOutputAmis returns the EC2 images for the output AMIs in the given region.
+// AMIs that cannot be described, such as those distributed to other accounts, are skipped.
+func imageBuilderImageDescribeOutputAmis(conn *ec2.EC2, region string, apiObject *imagebuilder.OutputResources) ([]*ec2.Image, error) {
+	if apiObject == nil {
+		return nil, nil
+	}
+
+	var ec2Images []*ec2.Image
+
+	for _, ami := range apiObject.Amis {
+		if ami == nil || aws.StringValue(ami.Region) != region {
+			continue
+		}
+
+		id := aws.StringValue(ami.Image)
+		ec2Image, err := finder.ImageByID(conn, id)
+
+		if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidAMIIDNotFound) || tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidAMIIDUnavailable) {
+			log.Printf("[WARN] Unable to describe Image Builder output AMI (%s): %s", id, err)
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error describing EC2 AMI (%s): %w", id, err)
+		}
+
+		if ec2Image == nil {
+			log.Printf("[WARN] Image Builder output AMI (%s) not found", id)
+			continue
+		}
+
+		ec2Images = append(ec2Images, ec2Image)
+	}
+
+	return ec2Images, nil
+}
+
+// imageBuilderImageBuildRegionDistributionWarning returns a warning when none of the distributions of the
+// distribution configuration target the build region, clarifying that an AMI is still created there.
+func imageBuilderImageBuildRegionDistributionWarning(buildRegion string, distributionConfiguration *imagebuilder.DistributionConfiguration) diag.Diagnostics {
+	if distributionConfiguration == nil || len(distributionConfiguration.Distributions) == 0 {
+		return nil
+	}
+
+	var regions []string
+
+	for _, distribution := range distributionConfiguration.Distributions {
+		if distribution == nil {
+			continue
+		}
+
+		region := aws.StringValue(distribution.Region)
+
+		if region == buildRegion {
+			return nil
+		}
+
+		regions = append(regions, region)
+	}
+
+	sort.Strings(regions)
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Image Builder Image build region is not a distribution region",
+			Detail:   fmt.Sprintf("The Image Builder Distribution Configuration (%s) distributes to %s, which does not include the build region (%s). Image Builder always creates the output AMI in the build region, so it is produced there even though no distribution settings, such as AMI name, tags or launch permissions, apply to it.", aws.StringValue(distributionConfiguration.Arn), strings.Join(regions, ", "), buildRegion),
+		},
+	}
+}
+
+// imageBuilderImageTestsTimeoutMinutes returns the number of minutes image tests may run, or 0 when image tests are disabled.
+func imageBuilderImageTestsTimeoutMinutes(imageTestsConfiguration *imagebuilder.ImageTestsConfiguration) int64 {
+	// The API defaults to enabled image tests with a 720 minute timeout.
+	timeoutMinutes := int64(720)
+
+	if imageTestsConfiguration != nil {
+		if imageTestsConfiguration.ImageTestsEnabled != nil && !aws.BoolValue(imageTestsConfiguration.ImageTestsEnabled) {
+			return 0
+		}
+
+		if imageTestsConfiguration.TimeoutMinutes != nil {
+			timeoutMinutes = aws.Int64Value(imageTestsConfiguration.TimeoutMinutes)
+		}
+	}
+
+	return timeoutMinutes
+}
+
+// imageBuilderImageTestsTerminationWarning returns a warning when image tests that may run for a long time
+// are combined with an infrastructure configuration that keeps build instances running after a failure.
+func imageBuilderImageTestsTerminationWarning(imageTestsConfiguration *imagebuilder.ImageTestsConfiguration, infrastructureConfiguration *imagebuilder.InfrastructureConfiguration) diag.Diagnostics {
+	if infrastructureConfiguration == nil || aws.BoolValue(infrastructureConfiguration.TerminateInstanceOnFailure) {
+		return nil
+	}
+
+	timeoutMinutes := imageBuilderImageTestsTimeoutMinutes(imageTestsConfiguration)
+
+	if timeoutMinutes <= imageBuilderImageTestsLongTimeoutMinutes {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Image Builder build instances are not terminated on failure",
+			Detail:   fmt.Sprintf("The Image Builder Infrastructure Configuration (%s) has terminate_instance_on_failure disabled and image tests may run for up to %d minutes. Build instances of failed or timed out images keep running, and incurring charges, until terminated manually.", aws.StringValue(infrastructureConfiguration.Arn), timeoutMinutes),
+		},
+	}
+}
+
+// imageBuilderImageEnhancedImageMetadataEnabledWarning returns a warning when the enhanced image metadata
+// setting sent on creation differs from the setting of the built Image.
+func imageBuilderImageEnhancedImageMetadataEnabledWarning(configured *bool, image *imagebuilder.Image) diag.Diagnostics {
+	if configured == nil || image == nil || image.EnhancedImageMetadataEnabled == nil {
+		return nil
+	}
+
+	if aws.BoolValue(configured) == aws.BoolValue(image.EnhancedImageMetadataEnabled) {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Image Builder Image enhanced image metadata setting not applied",
+			Detail:   fmt.Sprintf("The Image Builder Image (%s) was created with enhanced_image_metadata_enabled set to %t, but the image reports %t. The next plan shows a difference for this argument.", aws.StringValue(image.Arn), aws.BoolValue(configured), aws.BoolValue(image.EnhancedImageMetadataEnabled)),
+		},
+	}
+}
+
+// imageBuilderImageTargetRepositories returns the container repositories targeted by the distributions of
+// the distribution configuration included in the image. The distribution configuration is only read separately
+// when the image does not include its distributions. A distribution configuration that no longer exists has none.
+func imageBuilderImageTargetRepositories(ctx context.Context, conn *imagebuilder.Imagebuilder, distributionConfiguration *imagebuilder.DistributionConfiguration) ([]interface{}, error) {
+	if distributionConfiguration == nil {
+		return nil, nil
+	}
+
+	if len(distributionConfiguration.Distributions) > 0 {
+		return flattenImageBuilderTargetRepositories(distributionConfiguration.Distributions), nil
+	}
+
+	distributionConfigurationArn := aws.StringValue(distributionConfiguration.Arn)
+
+	if distributionConfigurationArn == "" {
+		return nil, nil
+	}
+
+	output, err := conn.GetDistributionConfigurationWithContext(ctx, &imagebuilder.GetDistributionConfigurationInput{
+		DistributionConfigurationArn: aws.String(distributionConfigurationArn),
+	})
+
+	if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Image Builder Distribution Configuration (%s) not found, unable to read target repositories", distributionConfigurationArn)
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error getting Image Builder Distribution Configuration (%s): %w", distributionConfigurationArn, err)
+	}
+
+	if output == nil || output.DistributionConfiguration == nil {
+		return nil, nil
+	}
+
+	return flattenImageBuilderTargetRepositories(output.DistributionConfiguration.Distributions), nil
+}
+
+// imageBuilderImageLogsEncrypted returns whether the S3 bucket receiving the image build logs
+// has default server side encryption configured. Images without S3 logging return false.
+func imageBuilderImageLogsEncrypted(conn *s3.S3, infrastructureConfiguration *imagebuilder.InfrastructureConfiguration) (bool, error) {
+	if infrastructureConfiguration == nil || infrastructureConfiguration.Logging == nil || infrastructureConfiguration.Logging.S3Logs == nil {
+		return false, nil
+	}
+
+	bucket := aws.StringValue(infrastructureConfiguration.Logging.S3Logs.S3BucketName)
+
+	if bucket == "" {
+		return false, nil
+	}
+
+	output, err := conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+	})
+
+	if tfawserr.ErrCodeEquals(err, "ServerSideEncryptionConfigurationNotFoundError") {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("error getting S3 Bucket (%s) encryption: %w", bucket, err)
+	}
+
+	if output == nil || output.ServerSideEncryptionConfiguration == nil {
+		return false, nil
+	}
+
+	return len(output.ServerSideEncryptionConfiguration.Rules) > 0, nil
+}
+
+// imageBuilderImageLogsUri returns the S3 location of the build logs for the Image, composed of the
+// infrastructure configuration S3 logging bucket and key prefix, the image name and the image build version.
+// Images without S3 logging return an empty string.
+func imageBuilderImageLogsUri(image *imagebuilder.Image) string {
+	if image == nil || image.InfrastructureConfiguration == nil || image.InfrastructureConfiguration.Logging == nil || image.InfrastructureConfiguration.Logging.S3Logs == nil {
+		return ""
+	}
+
+	bucket := aws.StringValue(image.InfrastructureConfiguration.Logging.S3Logs.S3BucketName)
+
+	if bucket == "" {
+		return ""
+	}
+
+	var parts []string
+
+	if v := strings.Trim(aws.StringValue(image.InfrastructureConfiguration.Logging.S3Logs.S3KeyPrefix), "/"); v != "" {
+		parts = append(parts, v)
+	}
+
+	parts = append(parts, aws.StringValue(image.Name), aws.StringValue(image.Version))
+
+	return fmt.Sprintf("s3://%s/%s/", bucket, strings.Join(parts, "/"))
+}
+
+// imageBuilderImageFailureReason returns the reason reported for a cancelled or failed Image build.
+// Other states return an empty string.
+func imageBuilderImageFailureReason(state *imagebuilder.ImageState) string {
+	if state == nil {
+		return ""
+	}
+
+	switch aws.StringValue(state.Status) {
+	case imagebuilder.ImageStatusCancelled, imagebuilder.ImageStatusFailed:
+		return aws.StringValue(state.Reason)
+	}
+
+	return ""
+}
+
+// imageBuilderImageFailedComponent returns the ARN of the first component named in an Image build failure reason,
+// or an empty string when the reason does not name a component.
+func imageBuilderImageFailedComponent(reason string) string {
+	return imageBuilderImageFailedComponentRegexp.FindString(reason)
+}
+
+// imageBuilderContainerImageUriRepositoryAndTag splits a container image URI, such as
+// 123456789012.dkr.ecr.us-east-1.amazonaws.com/example:1.0.0-1, into its repository and tag.
+// The tag is empty when the URI has none or references the image by digest.
+func imageBuilderContainerImageUriRepositoryAndTag(uri string) (string, string) {
+	if i := strings.Index(uri, "@"); i != -1 {
+		return uri[:i], ""
+	}
+
+	// The registry host may include a port, so only a colon after the last slash separates the tag.
+	if i := strings.LastIndex(uri, ":"); i != -1 && i > strings.LastIndex(uri, "/") {
+		return uri[:i], uri[i+1:]
+	}
+
+	return uri, ""
+}
+
+func imageBuilderImagePutEvent(ctx context.Context, conn *cloudwatchevents.CloudWatchEvents, entry *cloudwatchevents.PutEventsRequestEntry) error {
+	output, err := conn.PutEventsWithContext(ctx, &cloudwatchevents.PutEventsInput{
+		Entries: []*cloudwatchevents.PutEventsRequestEntry{entry},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if output != nil && aws.Int64Value(output.FailedEntryCount) > 0 {
+		for _, v := range output.Entries {
+			if v != nil && v.ErrorCode != nil {
+				return fmt.Errorf("%s: %s", aws.StringValue(v.ErrorCode), aws.StringValue(v.ErrorMessage))
+			}
+		}
+
+		return fmt.Errorf("%d failed entries", aws.Int64Value(output.FailedEntryCount))
+	}
+
+	return nil
+}
+
+// imageBuilderImageParentOsVersion returns the OS version of an Image Builder parent image ARN, such as
+// arn:aws:imagebuilder:us-east-1:aws:image/windows-server-2022-english-full-base-x86/x.x.x, by listing the
+// versions of the image with the same name and selecting the one the ARN version refers to.
+// Parent AMI identifiers and lookup errors return an empty string.
+func imageBuilderImageParentOsVersion(ctx context.Context, conn *imagebuilder.Imagebuilder, accountID string, parentImage string) string {
+	parsedARN, err := arn.Parse(parentImage)
+
+	if err != nil {
+		return ""
+	}
+
+	resourceParts := strings.Split(parsedARN.Resource, tfimagebuilder.ARNSeparator)
+
+	if len(resourceParts) < 2 || resourceParts[0] != "image" {
+		return ""
+	}
+
+	owner := imagebuilder.OwnershipShared
+
+	switch parsedARN.AccountID {
+	case tfimagebuilder.ARNAccountIDAws:
+		owner = imagebuilder.OwnershipAmazon
+	case accountID:
+		owner = imagebuilder.OwnershipSelf
+	}
+
+	input := &imagebuilder.ListImagesInput{
+		Filters: []*imagebuilder.Filter{
+			{
+				Name:   aws.String("name"),
+				Values: aws.StringSlice([]string{resourceParts[1]}),
+			},
+		},
+		Owner: aws.String(owner),
+	}
+
+	var imageVersions []*imagebuilder.ImageVersion
+
+	err = conn.ListImagesPagesWithContext(ctx, input, func(page *imagebuilder.ListImagesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		imageVersions = append(imageVersions, page.ImageVersionList...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		log.Printf("[WARN] Unable to read Image Builder parent image (%s) OS version: %s", parentImage, err)
+		return ""
+	}
+
+	versionFilter := "x.x.x"
+
+	if len(resourceParts) > 2 {
+		versionFilter = resourceParts[2]
+	}
+
+	return imageBuilderImageVersionsOsVersion(versionFilter, imageVersions)
+}
+
+// imageBuilderImageVersionsOsVersion returns the OS version of the highest image version matching
+// the semantic version filter, such as 1.0.0 or 2021.x.x, or an empty string when none match.
+func imageBuilderImageVersionsOsVersion(versionFilter string, imageVersions []*imagebuilder.ImageVersion) string {
+	var osVersion, latestVersion string
+
+	for _, imageVersion := range imageVersions {
+		if imageVersion == nil || aws.StringValue(imageVersion.OsVersion) == "" {
+			continue
+		}
+
+		version := aws.StringValue(imageVersion.Version)
+
+		if match, err := tfimagebuilder.SemanticVersionMatch(versionFilter, version); err != nil || !match {
+			continue
+		}
+
+		if latestVersion != "" {
+			if result, err := tfimagebuilder.SemanticVersionCompare(version, latestVersion); err != nil || result <= 0 {
+				continue
+			}
+		}
+
+		osVersion = aws.StringValue(imageVersion.OsVersion)
+		latestVersion = version
+	}
+
+	return osVersion
+}
+
+// imageBuilderImageTagOutputAmis tags the output AMIs in the current region and account.
+// AMIs distributed to other regions or accounts cannot be tagged and are skipped.
+func imageBuilderImageTagOutputAmis(conn *ec2.EC2, region string, accountID string, apiObject *imagebuilder.OutputResources, tags map[string]string) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	for _, ami := range apiObject.Amis {
+		if ami == nil || aws.StringValue(ami.Region) != region {
+			continue
+		}
+
+		if v := aws.StringValue(ami.AccountId); v != "" && v != accountID {
+			continue
+		}
+
+		id := aws.StringValue(ami.Image)
+
+		if err := keyvaluetags.Ec2CreateTags(conn, id, tags); err != nil {
+			return fmt.Errorf("error tagging EC2 AMI (%s): %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// imageBuilderImageEffectiveAmiTags returns the tags of the output AMI in the given region and account,
+// which reflect any resolved ami_tags templating in the distribution configuration.
+// When there is no such AMI, or it cannot be described, no tags are returned.
+func imageBuilderImageEffectiveAmiTags(conn *ec2.EC2, region string, accountID string, apiObject *imagebuilder.OutputResources) (map[string]string, error) {
+	if apiObject == nil {
+		return nil, nil
+	}
+
+	var ids []string
+
+	for _, ami := range apiObject.Amis {
+		if ami == nil || aws.StringValue(ami.Region) != region {
+			continue
+		}
+
+		if v := aws.StringValue(ami.AccountId); v != "" && v != accountID {
+			continue
+		}
+
+		ids = append(ids, aws.StringValue(ami.Image))
+	}
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	if len(ids) > 1 {
+		log.Printf("[WARN] Found multiple Image Builder output AMIs (%s) in region (%s), using the first for effective_ami_tags", strings.Join(ids, ", "), region)
+	}
+
+	ec2Image, err := finder.ImageByID(conn, ids[0])
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidAMIIDNotFound) || tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidAMIIDUnavailable) {
+		log.Printf("[WARN] Unable to describe Image Builder output AMI (%s): %s", ids[0], err)
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error describing EC2 AMI (%s): %w", ids[0], err)
+	}
+
+	if ec2Image == nil {
+		log.Printf("[WARN] Image Builder output AMI (%s) not found", ids[0])
+		return nil, nil
+	}
+
+	return keyvaluetags.Ec2KeyValueTags(ec2Image.Tags).IgnoreAws().Map(), nil
+}
+
+// imageBuilderImageAmiKmsKeyIds returns the KMS key encrypting the EBS snapshots of each EC2 image.
+// Images without encrypted snapshots are omitted.
+func imageBuilderImageAmiKmsKeyIds(conn *ec2.EC2, ec2Images []*ec2.Image) ([]interface{}, error) {
+	var tfList []interface{}
+
+	for _, ec2Image := range ec2Images {
+		for _, blockDeviceMapping := range ec2Image.BlockDeviceMappings {
+			if blockDeviceMapping == nil || blockDeviceMapping.Ebs == nil || !aws.BoolValue(blockDeviceMapping.Ebs.Encrypted) {
+				continue
+			}
+
+			id := aws.StringValue(blockDeviceMapping.Ebs.SnapshotId)
+			snapshot, err := finder.SnapshotByID(conn, id)
+
+			if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidSnapshotNotFound) {
+				log.Printf("[WARN] Unable to describe EBS Snapshot (%s) of AMI (%s): %s", id, aws.StringValue(ec2Image.ImageId), err)
+				continue
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("error describing EBS Snapshot (%s): %w", id, err)
+			}
+
+			if snapshot == nil || snapshot.KmsKeyId == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"image":      aws.StringValue(ec2Image.ImageId),
+				"kms_key_id": aws.StringValue(snapshot.KmsKeyId),
+			})
+
+			break
+		}
+	}
+
+	return tfList, nil
+}
+
+func expandImageBuilderImageEventBridgeEntry(tfMap map[string]interface{}, image *imagebuilder.Image) (*cloudwatchevents.PutEventsRequestEntry, error) {
+	if tfMap == nil || image == nil {
+		return nil, nil
+	}
+
+	var amis []interface{}
+
+	if image.OutputResources != nil {
+		for _, ami := range image.OutputResources.Amis {
+			if ami == nil {
+				continue
+			}
+
+			amis = append(amis, map[string]interface{}{
+				"accountId": aws.StringValue(ami.AccountId),
+				"image":     aws.StringValue(ami.Image),
+				"name":      aws.StringValue(ami.Name),
+				"region":    aws.StringValue(ami.Region),
+			})
+		}
+	}
+
+	detail, err := json.Marshal(map[string]interface{}{
+		"imageBuildVersionArn": aws.StringValue(image.Arn),
+		"outputResources": map[string]interface{}{
+			"amis": amis,
+		},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	apiObject := &cloudwatchevents.PutEventsRequestEntry{
+		Detail:    aws.String(string(detail)),
+		Resources: aws.StringSlice([]string{aws.StringValue(image.Arn)}),
+		Source:    aws.String(imageBuilderImageEventSource),
+	}
+
+	if v, ok := tfMap["detail_type"].(string); ok && v != "" {
+		apiObject.DetailType = aws.String(v)
+	}
+
+	if v, ok := tfMap["event_bus_name"].(string); ok && v != "" {
+		apiObject.EventBusName = aws.String(v)
+	}
+
+	return apiObject, nil
+}
+
+func flattenImageBuilderRecipeComponentArns(apiObjects []*imagebuilder.ComponentConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, aws.StringValue(apiObject.ComponentArn))
+	}
+
+	return tfList
+}
+
+func flattenImageBuilderOutputResources(apiObject *imagebuilder.OutputResources) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Amis; v != nil {
+		tfMap["amis"] = flattenImageBuilderAmis(v)
+	}
+
+	if v := apiObject.Containers; v != nil {
+		tfMap["containers"] = flattenImageBuilderContainers(v)
 	}
 
 	return tfMap
@@ -349,3 +1448,156 @@ func flattenImageBuilderAmis(apiObjects []*imagebuilder.Ami) []interface{} {
 
 	return tfList
 }
+
+func flattenImageBuilderContainer(apiObject *imagebuilder.Container) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ImageUris; v != nil {
+		tfMap["image_uris"] = aws.StringValueSlice(v)
+		tfMap["images"] = flattenImageBuilderContainerImageUris(v)
+	}
+
+	if v := apiObject.Region; v != nil {
+		tfMap["region"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenImageBuilderContainerImageUris(apiObjects []*string) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		uri := aws.StringValue(apiObject)
+		repository, tag := imageBuilderContainerImageUriRepositoryAndTag(uri)
+
+		tfList = append(tfList, map[string]interface{}{
+			"repository": repository,
+			"tag":        tag,
+			"uri":        uri,
+		})
+	}
+
+	return tfList
+}
+
+func flattenImageBuilderContainers(apiObjects []*imagebuilder.Container) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenImageBuilderContainer(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenImageBuilderTargetRepositories(apiObjects []*imagebuilder.Distribution) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.ContainerDistributionConfiguration == nil || apiObject.ContainerDistributionConfiguration.TargetRepository == nil {
+			continue
+		}
+
+		targetRepository := apiObject.ContainerDistributionConfiguration.TargetRepository
+
+		tfList = append(tfList, map[string]interface{}{
+			"region":          aws.StringValue(apiObject.Region),
+			"repository_name": aws.StringValue(targetRepository.RepositoryName),
+			"service":         aws.StringValue(targetRepository.Service),
+		})
+	}
+
+	return tfList
+}
+
+func flattenImageBuilderAmiArchitectures(ec2Images []*ec2.Image) map[string]string {
+	tfMap := map[string]string{}
+
+	for _, ec2Image := range ec2Images {
+		if ec2Image == nil || ec2Image.ImageId == nil || ec2Image.Architecture == nil {
+			continue
+		}
+
+		tfMap[aws.StringValue(ec2Image.ImageId)] = aws.StringValue(ec2Image.Architecture)
+	}
+
+	return tfMap
+}
+
+// flattenImageBuilderAmiFootprint returns the number of EC2 images, the number of their EBS snapshots
+// and the total provisioned size of the snapshot volumes in GiB, as a summary of the storage they consume.
+func flattenImageBuilderAmiFootprint(ec2Images []*ec2.Image) map[string]interface{} {
+	var amiCount, snapshotCount int
+	var totalVolumeSize int64
+
+	for _, ec2Image := range ec2Images {
+		if ec2Image == nil {
+			continue
+		}
+
+		amiCount++
+
+		for _, blockDeviceMapping := range ec2Image.BlockDeviceMappings {
+			if blockDeviceMapping == nil || blockDeviceMapping.Ebs == nil || blockDeviceMapping.Ebs.SnapshotId == nil {
+				continue
+			}
+
+			snapshotCount++
+			totalVolumeSize += aws.Int64Value(blockDeviceMapping.Ebs.VolumeSize)
+		}
+	}
+
+	return map[string]interface{}{
+		"ami_count":         amiCount,
+		"snapshot_count":    snapshotCount,
+		"total_volume_size": int(totalVolumeSize),
+	}
+}
+
+func flattenImageBuilderAmiSnapshotIds(ec2Images []*ec2.Image) []interface{} {
+	var tfList []interface{}
+
+	for _, ec2Image := range ec2Images {
+		var snapshotIds []*string
+
+		for _, blockDeviceMapping := range ec2Image.BlockDeviceMappings {
+			if blockDeviceMapping == nil || blockDeviceMapping.Ebs == nil || blockDeviceMapping.Ebs.SnapshotId == nil {
+				continue
+			}
+
+			snapshotIds = append(snapshotIds, blockDeviceMapping.Ebs.SnapshotId)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"image":        aws.StringValue(ec2Image.ImageId),
+			"snapshot_ids": flattenStringSet(snapshotIds),
+		})
+	}
+
+	return tfList
+}
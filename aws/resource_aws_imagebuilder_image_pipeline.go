@@ -234,7 +234,7 @@ func resourceAwsImageBuilderImagePipelineRead(d *schema.ResourceData, meta inter
 
 	d.Set("infrastructure_configuration_arn", imagePipeline.InfrastructureConfigurationArn)
 	d.Set("name", imagePipeline.Name)
-	d.Set("platform", imagePipeline.Platform)
+	d.Set("platform", imageBuilderImagePipelinePlatform(conn, imagePipeline))
 
 	if imagePipeline.Schedule != nil {
 		d.Set("schedule", []interface{}{flattenImageBuilderSchedule(imagePipeline.Schedule)})
@@ -333,6 +333,46 @@ func resourceAwsImageBuilderImagePipelineDelete(d *schema.ResourceData, meta int
 	return nil
 }
 
+// imageBuilderImagePipelinePlatform returns the platform of the image pipeline. When the pipeline
+// does not report one, it is resolved on a best-effort basis from the referenced recipe.
+func imageBuilderImagePipelinePlatform(conn *imagebuilder.Imagebuilder, imagePipeline *imagebuilder.ImagePipeline) *string {
+	if imagePipeline.Platform != nil {
+		return imagePipeline.Platform
+	}
+
+	if v := aws.StringValue(imagePipeline.ImageRecipeArn); v != "" {
+		output, err := conn.GetImageRecipe(&imagebuilder.GetImageRecipeInput{
+			ImageRecipeArn: aws.String(v),
+		})
+
+		if err != nil {
+			log.Printf("[WARN] Unable to resolve Image Builder Image Pipeline (%s) platform from Image Recipe (%s): %s", aws.StringValue(imagePipeline.Arn), v, err)
+			return nil
+		}
+
+		if output != nil && output.ImageRecipe != nil {
+			return output.ImageRecipe.Platform
+		}
+	}
+
+	if v := aws.StringValue(imagePipeline.ContainerRecipeArn); v != "" {
+		output, err := conn.GetContainerRecipe(&imagebuilder.GetContainerRecipeInput{
+			ContainerRecipeArn: aws.String(v),
+		})
+
+		if err != nil {
+			log.Printf("[WARN] Unable to resolve Image Builder Image Pipeline (%s) platform from Container Recipe (%s): %s", aws.StringValue(imagePipeline.Arn), v, err)
+			return nil
+		}
+
+		if output != nil && output.ContainerRecipe != nil {
+			return output.ContainerRecipe.Platform
+		}
+	}
+
+	return nil
+}
+
 func expandImageBuilderImageTestConfiguration(tfMap map[string]interface{}) *imagebuilder.ImageTestsConfiguration {
 	if tfMap == nil {
 		return nil
@@ -103,6 +103,7 @@ func TestAccAwsImageBuilderImagePipeline_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "infrastructure_configuration_arn", infrastructureConfigurationResourceName, "arn"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "platform", imagebuilder.PlatformLinux),
+					resource.TestCheckResourceAttrPair(resourceName, "platform", imageRecipeResourceName, "platform"),
 					resource.TestCheckResourceAttr(resourceName, "schedule.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "status", imagebuilder.PipelineStatusEnabled),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
@@ -228,9 +229,18 @@ func TestAccAwsImageBuilderImagePipeline_EnhancedImageMetadataEnabled(t *testing
 				Config: testAccAwsImageBuilderImagePipelineConfigEnhancedImageMetadataEnabled(rName, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsImageBuilderImagePipelineExists(resourceName),
+					testAccCheckResourceAttrRfc3339(resourceName, "date_updated"),
 					resource.TestCheckResourceAttr(resourceName, "enhanced_image_metadata_enabled", "true"),
 				),
 			},
+			{
+				Config: testAccAwsImageBuilderImagePipelineConfigEnhancedImageMetadataEnabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImagePipelineExists(resourceName),
+					testAccCheckResourceAttrRfc3339(resourceName, "date_updated"),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_image_metadata_enabled", "false"),
+				),
+			},
 		},
 	})
 }
@@ -386,6 +396,7 @@ func TestAccAwsImageBuilderImagePipeline_Schedule_PipelineExecutionStartConditio
 					testAccCheckAwsImageBuilderImagePipelineExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "schedule.0.pipeline_execution_start_condition", imagebuilder.PipelineExecutionStartConditionExpressionMatchAndDependencyUpdatesAvailable),
+					testAccCheckAwsImageBuilderImagePipelinePipelineExecutionStartCondition(resourceName, imagebuilder.PipelineExecutionStartConditionExpressionMatchAndDependencyUpdatesAvailable),
 				),
 			},
 			{
@@ -399,6 +410,7 @@ func TestAccAwsImageBuilderImagePipeline_Schedule_PipelineExecutionStartConditio
 					testAccCheckAwsImageBuilderImagePipelineExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "schedule.0.pipeline_execution_start_condition", imagebuilder.PipelineExecutionStartConditionExpressionMatchOnly),
+					testAccCheckAwsImageBuilderImagePipelinePipelineExecutionStartCondition(resourceName, imagebuilder.PipelineExecutionStartConditionExpressionMatchOnly),
 				),
 			},
 		},
@@ -567,6 +579,39 @@ func testAccCheckAwsImageBuilderImagePipelineExists(resourceName string) resourc
 	}
 }
 
+// testAccCheckAwsImageBuilderImagePipelinePipelineExecutionStartCondition verifies the schedule start condition
+// reported by the API, rather than the value in the Terraform state.
+func testAccCheckAwsImageBuilderImagePipelinePipelineExecutionStartCondition(resourceName string, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+
+		input := &imagebuilder.GetImagePipelineInput{
+			ImagePipelineArn: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.GetImagePipeline(input)
+
+		if err != nil {
+			return fmt.Errorf("error getting Image Builder Image Pipeline (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output == nil || output.ImagePipeline == nil || output.ImagePipeline.Schedule == nil {
+			return fmt.Errorf("Image Builder Image Pipeline (%s) has no schedule", rs.Primary.ID)
+		}
+
+		if actual := aws.StringValue(output.ImagePipeline.Schedule.PipelineExecutionStartCondition); actual != expected {
+			return fmt.Errorf("Image Builder Image Pipeline (%s) pipeline execution start condition is %s, expected %s", rs.Primary.ID, actual, expected)
+		}
+
+		return nil
+	}
+}
+
 func testAccAwsImageBuilderImagePipelineConfigBase(rName string) string {
 	return fmt.Sprintf(`
 data "aws_region" "current" {}
@@ -1,9 +1,12 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
@@ -25,6 +28,8 @@ func resourceAwsImageBuilderImageRecipe() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsImageBuilderImageRecipeCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -133,9 +138,17 @@ func resourceAwsImageBuilderImageRecipe() *schema.Resource {
 							Required:     true,
 							ValidateFunc: validateArn,
 						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
+			"component_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -145,6 +158,10 @@ func resourceAwsImageBuilderImageRecipe() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 1024),
 			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -264,8 +281,10 @@ func resourceAwsImageBuilderImageRecipeRead(d *schema.ResourceData, meta interfa
 	d.Set("arn", imageRecipe.Arn)
 	d.Set("block_device_mapping", flattenImageBuilderInstanceBlockDeviceMappings(imageRecipe.BlockDeviceMappings))
 	d.Set("component", flattenImageBuilderComponentConfigurations(imageRecipe.Components))
+	d.Set("component_count", len(imageRecipe.Components))
 	d.Set("date_created", imageRecipe.DateCreated)
 	d.Set("description", imageRecipe.Description)
+	d.Set("encrypted", imageBuilderInstanceBlockDeviceMappingsEncrypted(imageRecipe.BlockDeviceMappings))
 	d.Set("name", imageRecipe.Name)
 	d.Set("owner", imageRecipe.Owner)
 	d.Set("parent_image", imageRecipe.ParentImage)
@@ -417,6 +436,44 @@ func expandImageBuilderInstanceBlockDeviceMapping(tfMap map[string]interface{})
 	return apiObject
 }
 
+func resourceAwsImageBuilderImageRecipeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if deviceNames := imageBuilderInstanceBlockDeviceMappingsDuplicateDeviceNames(diff.Get("block_device_mapping").(*schema.Set).List()); len(deviceNames) > 0 {
+		return fmt.Errorf("block_device_mapping device_name values must be unique, duplicated: %s", strings.Join(deviceNames, ", "))
+	}
+
+	return nil
+}
+
+// imageBuilderInstanceBlockDeviceMappingsDuplicateDeviceNames returns the sorted device names used by more than one
+// flattened block device mapping. Mappings without a device name, such as those not known until apply, are ignored.
+func imageBuilderInstanceBlockDeviceMappingsDuplicateDeviceNames(tfList []interface{}) []string {
+	counts := make(map[string]int)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["device_name"].(string); ok && v != "" {
+			counts[v]++
+		}
+	}
+
+	var deviceNames []string
+
+	for deviceName, count := range counts {
+		if count > 1 {
+			deviceNames = append(deviceNames, deviceName)
+		}
+	}
+
+	sort.Strings(deviceNames)
+
+	return deviceNames
+}
+
 func expandImageBuilderInstanceBlockDeviceMappings(tfList []interface{}) []*imagebuilder.InstanceBlockDeviceMapping {
 	if len(tfList) == 0 {
 		return nil
@@ -452,6 +509,11 @@ func flattenImageBuilderComponentConfiguration(apiObject *imagebuilder.Component
 
 	if v := apiObject.ComponentArn; v != nil {
 		tfMap["component_arn"] = aws.StringValue(v)
+
+		// Best effort: wildcard and unparsable ARNs leave the version empty.
+		if version, err := tfimagebuilder.ComponentARNToSemanticVersion(aws.StringValue(v)); err == nil {
+			tfMap["version"] = version
+		}
 	}
 
 	return tfMap
@@ -556,3 +618,23 @@ func flattenImageBuilderInstanceBlockDeviceMappings(apiObjects []*imagebuilder.I
 
 	return tfList
 }
+
+// imageBuilderInstanceBlockDeviceMappingsEncrypted returns whether the mappings configure at least one EBS volume
+// and all configured EBS volumes are encrypted. Volumes inherited unchanged from the parent image are not considered.
+func imageBuilderInstanceBlockDeviceMappingsEncrypted(apiObjects []*imagebuilder.InstanceBlockDeviceMapping) bool {
+	var volumes int
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.Ebs == nil {
+			continue
+		}
+
+		if !aws.BoolValue(apiObject.Ebs.Encrypted) {
+			return false
+		}
+
+		volumes++
+	}
+
+	return volumes > 0
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 	"testing"
 
@@ -77,6 +78,80 @@ func testSweepImageBuilderImageRecipes(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func TestImageBuilderInstanceBlockDeviceMappingsEncrypted(t *testing.T) {
+	testCases := []struct {
+		TestName            string
+		BlockDeviceMappings []*imagebuilder.InstanceBlockDeviceMapping
+		Expected            bool
+	}{
+		{
+			TestName: "no mappings",
+		},
+		{
+			TestName: "no EBS mappings",
+			BlockDeviceMappings: []*imagebuilder.InstanceBlockDeviceMapping{
+				{
+					DeviceName:  aws.String("/dev/xvdb"),
+					VirtualName: aws.String("ephemeral0"),
+				},
+			},
+		},
+		{
+			TestName: "encrypted",
+			BlockDeviceMappings: []*imagebuilder.InstanceBlockDeviceMapping{
+				{
+					DeviceName: aws.String("/dev/xvda"),
+					Ebs:        &imagebuilder.EbsInstanceBlockDeviceSpecification{Encrypted: aws.Bool(true)},
+				},
+				{
+					DeviceName:  aws.String("/dev/xvdb"),
+					VirtualName: aws.String("ephemeral0"),
+				},
+			},
+			Expected: true,
+		},
+		{
+			TestName: "unencrypted",
+			BlockDeviceMappings: []*imagebuilder.InstanceBlockDeviceMapping{
+				{
+					DeviceName: aws.String("/dev/xvda"),
+					Ebs:        &imagebuilder.EbsInstanceBlockDeviceSpecification{Encrypted: aws.Bool(false)},
+				},
+			},
+		},
+		{
+			TestName: "encryption unspecified",
+			BlockDeviceMappings: []*imagebuilder.InstanceBlockDeviceMapping{
+				{
+					DeviceName: aws.String("/dev/xvda"),
+					Ebs:        &imagebuilder.EbsInstanceBlockDeviceSpecification{VolumeSize: aws.Int64(20)},
+				},
+			},
+		},
+		{
+			TestName: "partially encrypted",
+			BlockDeviceMappings: []*imagebuilder.InstanceBlockDeviceMapping{
+				{
+					DeviceName: aws.String("/dev/xvda"),
+					Ebs:        &imagebuilder.EbsInstanceBlockDeviceSpecification{Encrypted: aws.Bool(true)},
+				},
+				{
+					DeviceName: aws.String("/dev/xvdb"),
+					Ebs:        &imagebuilder.EbsInstanceBlockDeviceSpecification{Encrypted: aws.Bool(false)},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := imageBuilderInstanceBlockDeviceMappingsEncrypted(testCase.BlockDeviceMappings); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestAccAwsImageBuilderImageRecipe_basic(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_imagebuilder_image_recipe.test"
@@ -93,6 +168,7 @@ func TestAccAwsImageBuilderImageRecipe_basic(t *testing.T) {
 					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "imagebuilder", regexp.MustCompile(fmt.Sprintf("image-recipe/%s/1.0.0", rName))),
 					resource.TestCheckResourceAttr(resourceName, "block_device_mapping.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "component.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "component_count", "1"),
 					testAccCheckResourceAttrRfc3339(resourceName, "date_created"),
 					resource.TestCheckResourceAttr(resourceName, "description", ""),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
@@ -133,6 +209,54 @@ func TestAccAwsImageBuilderImageRecipe_disappears(t *testing.T) {
 	})
 }
 
+func TestImageBuilderInstanceBlockDeviceMappingsDuplicateDeviceNames(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		TfList   []interface{}
+		Expected []string
+	}{
+		{
+			TestName: "no mappings",
+		},
+		{
+			TestName: "root and data volumes",
+			TfList: []interface{}{
+				map[string]interface{}{"device_name": "/dev/xvda"},
+				map[string]interface{}{"device_name": "/dev/xvdb"},
+				map[string]interface{}{"device_name": "/dev/xvdc"},
+			},
+		},
+		{
+			TestName: "unknown device names",
+			TfList: []interface{}{
+				map[string]interface{}{"device_name": ""},
+				map[string]interface{}{"device_name": ""},
+			},
+		},
+		{
+			TestName: "duplicate device names",
+			TfList: []interface{}{
+				map[string]interface{}{"device_name": "/dev/xvdc"},
+				map[string]interface{}{"device_name": "/dev/xvda"},
+				map[string]interface{}{"device_name": "/dev/xvdc"},
+				map[string]interface{}{"device_name": "/dev/xvdb"},
+				map[string]interface{}{"device_name": "/dev/xvdb"},
+			},
+			Expected: []string{"/dev/xvdb", "/dev/xvdc"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := imageBuilderInstanceBlockDeviceMappingsDuplicateDeviceNames(testCase.TfList)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestAccAwsImageBuilderImageRecipe_BlockDeviceMapping_DeviceName(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_imagebuilder_image_recipe.test"
@@ -161,6 +285,47 @@ func TestAccAwsImageBuilderImageRecipe_BlockDeviceMapping_DeviceName(t *testing.
 	})
 }
 
+func TestAccAwsImageBuilderImageRecipe_BlockDeviceMapping_Multiple(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_imagebuilder_image_recipe.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderImageRecipeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsImageBuilderImageRecipeConfigBlockDeviceMappingMultiple(rName, "/dev/xvdb", "/dev/xvdb"),
+				ExpectError: regexp.MustCompile(`device_name values must be unique, duplicated: /dev/xvdb`),
+			},
+			{
+				Config: testAccAwsImageBuilderImageRecipeConfigBlockDeviceMappingMultiple(rName, "/dev/xvdb", "/dev/xvdc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageRecipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "block_device_mapping.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "block_device_mapping.*", map[string]string{
+						"device_name":       "/dev/xvda",
+						"ebs.0.volume_size": "20",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "block_device_mapping.*", map[string]string{
+						"device_name":       "/dev/xvdb",
+						"ebs.0.volume_size": "50",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "block_device_mapping.*", map[string]string{
+						"device_name":       "/dev/xvdc",
+						"ebs.0.volume_size": "100",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAwsImageBuilderImageRecipe_BlockDeviceMapping_Ebs_DeleteOnTermination(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_imagebuilder_image_recipe.test"
@@ -206,6 +371,7 @@ func TestAccAwsImageBuilderImageRecipe_BlockDeviceMapping_Ebs_Encrypted(t *testi
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "block_device_mapping.*", map[string]string{
 						"ebs.0.encrypted": "true",
 					}),
+					resource.TestCheckResourceAttr(resourceName, "encrypted", "true"),
 				),
 			},
 			{
@@ -213,6 +379,17 @@ func TestAccAwsImageBuilderImageRecipe_BlockDeviceMapping_Ebs_Encrypted(t *testi
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccAwsImageBuilderImageRecipeConfigBlockDeviceMappingEbsEncrypted(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageRecipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "block_device_mapping.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "block_device_mapping.*", map[string]string{
+						"ebs.0.encrypted": "false",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "encrypted", "false"),
+				),
+			},
 		},
 	})
 }
@@ -453,9 +630,13 @@ func TestAccAwsImageBuilderImageRecipe_Component(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsImageBuilderImageRecipeExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "component.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "component_count", "3"),
 					resource.TestCheckResourceAttrPair(resourceName, "component.0.component_arn", "data.aws_imagebuilder_component.aws-cli-version-2-linux", "arn"),
 					resource.TestCheckResourceAttrPair(resourceName, "component.1.component_arn", "data.aws_imagebuilder_component.update-linux", "arn"),
 					resource.TestCheckResourceAttrPair(resourceName, "component.2.component_arn", "aws_imagebuilder_component.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "component.0.version", "1.0.0"),
+					resource.TestCheckResourceAttr(resourceName, "component.1.version", "1.0.0"),
+					resource.TestCheckResourceAttrPair(resourceName, "component.2.version", "aws_imagebuilder_component.test", "version"),
 				),
 			},
 			{
@@ -661,6 +842,52 @@ resource "aws_imagebuilder_image_recipe" "test" {
 `, rName, deviceName))
 }
 
+func testAccAwsImageBuilderImageRecipeConfigBlockDeviceMappingMultiple(rName string, dataDeviceName1 string, dataDeviceName2 string) string {
+	return composeConfig(
+		testAccAwsImageBuilderImageRecipeConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_imagebuilder_image_recipe" "test" {
+  block_device_mapping {
+    device_name = "/dev/xvda"
+
+    ebs {
+      delete_on_termination = true
+      volume_size           = 20
+      volume_type           = "gp2"
+    }
+  }
+
+  block_device_mapping {
+    device_name = %[2]q
+
+    ebs {
+      delete_on_termination = true
+      volume_size           = 50
+      volume_type           = "gp2"
+    }
+  }
+
+  block_device_mapping {
+    device_name = %[3]q
+
+    ebs {
+      delete_on_termination = true
+      volume_size           = 100
+      volume_type           = "gp2"
+    }
+  }
+
+  component {
+    component_arn = aws_imagebuilder_component.test.arn
+  }
+
+  name         = %[1]q
+  parent_image = "arn:${data.aws_partition.current.partition}:imagebuilder:${data.aws_region.current.name}:aws:image/amazon-linux-2-x86/x.x.x"
+  version      = "1.0.0"
+}
+`, rName, dataDeviceName1, dataDeviceName2))
+}
+
 func testAccAwsImageBuilderImageRecipeConfigBlockDeviceMappingEbsDeleteOnTermination(rName string, deleteOnTermination bool) string {
 	return composeConfig(
 		testAccAwsImageBuilderImageRecipeConfigBase(rName),
@@ -1,17 +1,25 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -51,10 +59,10 @@ func testSweepImageBuilderImages(region string) error {
 			d := r.Data(nil)
 			d.SetId(arn)
 
-			err := r.Delete(d, client)
+			diags := r.DeleteContext(context.Background(), d, client)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Image Builder Image (%s): %w", arn, err)
+			if diags.HasError() {
+				sweeperErr := fmt.Errorf("error deleting Image Builder Image (%s): %s", arn, diags[0].Summary)
 				log.Printf("[ERROR] %s", sweeperErr)
 				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
 				continue
@@ -76,6 +84,907 @@ func testSweepImageBuilderImages(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func TestExpandImageBuilderImageEventBridgeEntry(t *testing.T) {
+	imageArn := "arn:aws:imagebuilder:us-east-1:123456789012:image/test/1.0.0/1"
+
+	testCases := []struct {
+		TestName             string
+		TfMap                map[string]interface{}
+		Image                *imagebuilder.Image
+		ExpectedDetail       string
+		ExpectedDetailType   string
+		ExpectedEventBusName string
+	}{
+		{
+			TestName: "no output resources",
+			TfMap: map[string]interface{}{
+				"detail_type":    "Image Builder Image Available",
+				"event_bus_name": "default",
+			},
+			Image: &imagebuilder.Image{
+				Arn: aws.String(imageArn),
+			},
+			ExpectedDetail:       `{"imageBuildVersionArn":"` + imageArn + `","outputResources":{"amis":null}}`,
+			ExpectedDetailType:   "Image Builder Image Available",
+			ExpectedEventBusName: "default",
+		},
+		{
+			TestName: "output AMIs",
+			TfMap: map[string]interface{}{
+				"detail_type":    "test",
+				"event_bus_name": "test-bus",
+			},
+			Image: &imagebuilder.Image{
+				Arn: aws.String(imageArn),
+				OutputResources: &imagebuilder.OutputResources{
+					Amis: []*imagebuilder.Ami{
+						{
+							AccountId: aws.String("123456789012"),
+							Image:     aws.String("ami-12345678"),
+							Name:      aws.String("test"),
+							Region:    aws.String("us-east-1"),
+						},
+					},
+				},
+			},
+			ExpectedDetail:       `{"imageBuildVersionArn":"` + imageArn + `","outputResources":{"amis":[{"accountId":"123456789012","image":"ami-12345678","name":"test","region":"us-east-1"}]}}`,
+			ExpectedDetailType:   "test",
+			ExpectedEventBusName: "test-bus",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := expandImageBuilderImageEventBridgeEntry(testCase.TfMap, testCase.Image)
+
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if actual, expected := aws.StringValue(got.Detail), testCase.ExpectedDetail; actual != expected {
+				t.Errorf("got detail %s, expected %s", actual, expected)
+			}
+
+			if actual, expected := aws.StringValue(got.DetailType), testCase.ExpectedDetailType; actual != expected {
+				t.Errorf("got detail type %s, expected %s", actual, expected)
+			}
+
+			if actual, expected := aws.StringValue(got.EventBusName), testCase.ExpectedEventBusName; actual != expected {
+				t.Errorf("got event bus name %s, expected %s", actual, expected)
+			}
+
+			if actual, expected := aws.StringValue(got.Source), imageBuilderImageEventSource; actual != expected {
+				t.Errorf("got source %s, expected %s", actual, expected)
+			}
+
+			if actual, expected := aws.StringValueSlice(got.Resources), []string{imageArn}; len(actual) != 1 || actual[0] != expected[0] {
+				t.Errorf("got resources %v, expected %v", actual, expected)
+			}
+		})
+	}
+}
+
+func TestImageBuilderImageBuildRegionDistributionWarning(t *testing.T) {
+	testCases := []struct {
+		TestName                  string
+		DistributionConfiguration *imagebuilder.DistributionConfiguration
+		ExpectWarning             bool
+	}{
+		{
+			TestName: "no distribution configuration",
+		},
+		{
+			TestName:                  "no distributions",
+			DistributionConfiguration: &imagebuilder.DistributionConfiguration{},
+		},
+		{
+			TestName: "build region only",
+			DistributionConfiguration: &imagebuilder.DistributionConfiguration{
+				Distributions: []*imagebuilder.Distribution{
+					{Region: aws.String("us-west-2")},
+				},
+			},
+		},
+		{
+			TestName: "build region and other region",
+			DistributionConfiguration: &imagebuilder.DistributionConfiguration{
+				Distributions: []*imagebuilder.Distribution{
+					{Region: aws.String("us-east-1")},
+					{Region: aws.String("us-west-2")},
+				},
+			},
+		},
+		{
+			TestName: "other regions only",
+			DistributionConfiguration: &imagebuilder.DistributionConfiguration{
+				Distributions: []*imagebuilder.Distribution{
+					{Region: aws.String("us-east-2")},
+					{Region: aws.String("us-east-1")},
+				},
+			},
+			ExpectWarning: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := imageBuilderImageBuildRegionDistributionWarning("us-west-2", testCase.DistributionConfiguration)
+
+			if testCase.ExpectWarning && len(got) != 1 {
+				t.Fatalf("expected 1 warning, got: %d", len(got))
+			}
+
+			if !testCase.ExpectWarning && len(got) != 0 {
+				t.Fatalf("expected no warnings, got: %d", len(got))
+			}
+
+			if len(got) > 0 && got[0].Severity != diag.Warning {
+				t.Errorf("expected warning severity, got: %v", got[0].Severity)
+			}
+
+			if len(got) > 0 && !strings.Contains(got[0].Detail, "distributes to us-east-1, us-east-2, which does not include the build region (us-west-2)") {
+				t.Errorf("unexpected warning detail: %s", got[0].Detail)
+			}
+		})
+	}
+}
+
+func TestImageBuilderImageEnhancedImageMetadataEnabledWarning(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Configured    *bool
+		Image         *imagebuilder.Image
+		ExpectWarning bool
+	}{
+		{
+			TestName:   "no image",
+			Configured: aws.Bool(false),
+		},
+		{
+			TestName:   "image without setting",
+			Configured: aws.Bool(false),
+			Image:      &imagebuilder.Image{},
+		},
+		{
+			TestName:   "enabled match",
+			Configured: aws.Bool(true),
+			Image:      &imagebuilder.Image{EnhancedImageMetadataEnabled: aws.Bool(true)},
+		},
+		{
+			TestName:   "disabled match",
+			Configured: aws.Bool(false),
+			Image:      &imagebuilder.Image{EnhancedImageMetadataEnabled: aws.Bool(false)},
+		},
+		{
+			TestName:      "disabled mismatch",
+			Configured:    aws.Bool(false),
+			Image:         &imagebuilder.Image{EnhancedImageMetadataEnabled: aws.Bool(true)},
+			ExpectWarning: true,
+		},
+		{
+			TestName:      "enabled mismatch",
+			Configured:    aws.Bool(true),
+			Image:         &imagebuilder.Image{EnhancedImageMetadataEnabled: aws.Bool(false)},
+			ExpectWarning: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := imageBuilderImageEnhancedImageMetadataEnabledWarning(testCase.Configured, testCase.Image)
+
+			if testCase.ExpectWarning && len(got) != 1 {
+				t.Fatalf("expected 1 warning, got: %d", len(got))
+			}
+
+			if !testCase.ExpectWarning && len(got) != 0 {
+				t.Fatalf("expected no warnings, got: %d", len(got))
+			}
+
+			if len(got) > 0 && got[0].Severity != diag.Warning {
+				t.Errorf("expected warning severity, got: %v", got[0].Severity)
+			}
+		})
+	}
+}
+
+func TestImageBuilderImageTestsTimeoutMinutes(t *testing.T) {
+	testCases := []struct {
+		TestName                string
+		ImageTestsConfiguration *imagebuilder.ImageTestsConfiguration
+		Expected                int64
+	}{
+		{
+			TestName: "default",
+			Expected: 720,
+		},
+		{
+			TestName:                "enabled default timeout",
+			ImageTestsConfiguration: &imagebuilder.ImageTestsConfiguration{ImageTestsEnabled: aws.Bool(true)},
+			Expected:                720,
+		},
+		{
+			TestName: "disabled",
+			ImageTestsConfiguration: &imagebuilder.ImageTestsConfiguration{
+				ImageTestsEnabled: aws.Bool(false),
+				TimeoutMinutes:    aws.Int64(1440),
+			},
+		},
+		{
+			TestName: "timeout",
+			ImageTestsConfiguration: &imagebuilder.ImageTestsConfiguration{
+				ImageTestsEnabled: aws.Bool(true),
+				TimeoutMinutes:    aws.Int64(60),
+			},
+			Expected: 60,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := imageBuilderImageTestsTimeoutMinutes(testCase.ImageTestsConfiguration); got != testCase.Expected {
+				t.Errorf("got %d, expected %d", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestImageBuilderImageTestsTerminationWarning(t *testing.T) {
+	testCases := []struct {
+		TestName                    string
+		ImageTestsConfiguration     *imagebuilder.ImageTestsConfiguration
+		InfrastructureConfiguration *imagebuilder.InfrastructureConfiguration
+		ExpectWarning               bool
+	}{
+		{
+			TestName: "no infrastructure configuration",
+		},
+		{
+			TestName:                    "terminate on failure",
+			InfrastructureConfiguration: &imagebuilder.InfrastructureConfiguration{TerminateInstanceOnFailure: aws.Bool(true)},
+		},
+		{
+			TestName:                    "default image tests",
+			InfrastructureConfiguration: &imagebuilder.InfrastructureConfiguration{TerminateInstanceOnFailure: aws.Bool(false)},
+			ExpectWarning:               true,
+		},
+		{
+			TestName: "image tests disabled",
+			ImageTestsConfiguration: &imagebuilder.ImageTestsConfiguration{
+				ImageTestsEnabled: aws.Bool(false),
+				TimeoutMinutes:    aws.Int64(720),
+			},
+			InfrastructureConfiguration: &imagebuilder.InfrastructureConfiguration{TerminateInstanceOnFailure: aws.Bool(false)},
+		},
+		{
+			TestName: "short timeout",
+			ImageTestsConfiguration: &imagebuilder.ImageTestsConfiguration{
+				ImageTestsEnabled: aws.Bool(true),
+				TimeoutMinutes:    aws.Int64(60),
+			},
+			InfrastructureConfiguration: &imagebuilder.InfrastructureConfiguration{TerminateInstanceOnFailure: aws.Bool(false)},
+		},
+		{
+			TestName: "long timeout",
+			ImageTestsConfiguration: &imagebuilder.ImageTestsConfiguration{
+				ImageTestsEnabled: aws.Bool(true),
+				TimeoutMinutes:    aws.Int64(1440),
+			},
+			InfrastructureConfiguration: &imagebuilder.InfrastructureConfiguration{TerminateInstanceOnFailure: aws.Bool(false)},
+			ExpectWarning:               true,
+		},
+		{
+			TestName: "long timeout terminate on failure",
+			ImageTestsConfiguration: &imagebuilder.ImageTestsConfiguration{
+				ImageTestsEnabled: aws.Bool(true),
+				TimeoutMinutes:    aws.Int64(1440),
+			},
+			InfrastructureConfiguration: &imagebuilder.InfrastructureConfiguration{TerminateInstanceOnFailure: aws.Bool(true)},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := imageBuilderImageTestsTerminationWarning(testCase.ImageTestsConfiguration, testCase.InfrastructureConfiguration)
+
+			if testCase.ExpectWarning && len(got) != 1 {
+				t.Fatalf("expected 1 warning, got: %d", len(got))
+			}
+
+			if !testCase.ExpectWarning && len(got) != 0 {
+				t.Fatalf("expected no warnings, got: %d", len(got))
+			}
+
+			if len(got) > 0 && got[0].Severity != diag.Warning {
+				t.Errorf("expected warning severity, got: %v", got[0].Severity)
+			}
+		})
+	}
+}
+
+func TestImageBuilderImageVersionsOsVersion(t *testing.T) {
+	imageVersions := []*imagebuilder.ImageVersion{
+		nil,
+		{
+			OsVersion: aws.String("Microsoft Windows Server 2019"),
+			Version:   aws.String("2020.12.9"),
+		},
+		{
+			OsVersion: aws.String("Microsoft Windows Server 2022"),
+			Version:   aws.String("2021.10.2"),
+		},
+		{
+			OsVersion: aws.String("Microsoft Windows Server 2022"),
+			Version:   aws.String("2021.9.15"),
+		},
+		{
+			Version: aws.String("2022.1.1"),
+		},
+	}
+
+	testCases := []struct {
+		TestName           string
+		InputVersionFilter string
+		InputImageVersions []*imagebuilder.ImageVersion
+		Expected           string
+	}{
+		{
+			TestName:           "no image versions",
+			InputVersionFilter: "x.x.x",
+		},
+		{
+			TestName:           "wildcard selects highest version",
+			InputVersionFilter: "x.x.x",
+			InputImageVersions: imageVersions,
+			Expected:           "Microsoft Windows Server 2022",
+		},
+		{
+			TestName:           "partial wildcard selects highest matching version",
+			InputVersionFilter: "2020.x.x",
+			InputImageVersions: imageVersions,
+			Expected:           "Microsoft Windows Server 2019",
+		},
+		{
+			TestName:           "exact version",
+			InputVersionFilter: "2020.12.9",
+			InputImageVersions: imageVersions,
+			Expected:           "Microsoft Windows Server 2019",
+		},
+		{
+			TestName:           "no matching version",
+			InputVersionFilter: "2019.x.x",
+			InputImageVersions: imageVersions,
+		},
+		{
+			TestName:           "invalid version filter",
+			InputVersionFilter: "latest",
+			InputImageVersions: imageVersions,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := imageBuilderImageVersionsOsVersion(testCase.InputVersionFilter, testCase.InputImageVersions)
+
+			if got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestImageBuilderImageLogsUri(t *testing.T) {
+	logging := func(bucket string, prefix string) *imagebuilder.InfrastructureConfiguration {
+		apiObject := &imagebuilder.InfrastructureConfiguration{
+			Logging: &imagebuilder.Logging{
+				S3Logs: &imagebuilder.S3Logs{
+					S3BucketName: aws.String(bucket),
+				},
+			},
+		}
+
+		if prefix != "" {
+			apiObject.Logging.S3Logs.S3KeyPrefix = aws.String(prefix)
+		}
+
+		return apiObject
+	}
+
+	testCases := []struct {
+		TestName                    string
+		InfrastructureConfiguration *imagebuilder.InfrastructureConfiguration
+		Expected                    string
+	}{
+		{
+			TestName: "no infrastructure configuration",
+		},
+		{
+			TestName:                    "no logging",
+			InfrastructureConfiguration: &imagebuilder.InfrastructureConfiguration{},
+		},
+		{
+			TestName:                    "bucket",
+			InfrastructureConfiguration: logging("example-bucket", ""),
+			Expected:                    "s3://example-bucket/example/1.0.0/2/",
+		},
+		{
+			TestName:                    "bucket and prefix",
+			InfrastructureConfiguration: logging("example-bucket", "logs"),
+			Expected:                    "s3://example-bucket/logs/example/1.0.0/2/",
+		},
+		{
+			TestName:                    "bucket and prefix with slashes",
+			InfrastructureConfiguration: logging("example-bucket", "/logs/imagebuilder/"),
+			Expected:                    "s3://example-bucket/logs/imagebuilder/example/1.0.0/2/",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			image := &imagebuilder.Image{
+				InfrastructureConfiguration: testCase.InfrastructureConfiguration,
+				Name:                        aws.String("example"),
+				Version:                     aws.String("1.0.0/2"),
+			}
+
+			if got := imageBuilderImageLogsUri(image); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestImageBuilderImageCreateInterrupted(t *testing.T) {
+	t.Run("not done", func(t *testing.T) {
+		if imageBuilderImageCreateInterrupted(context.Background()) {
+			t.Errorf("expected not interrupted")
+		}
+	})
+
+	t.Run("cancelled mid-wait", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+		}()
+
+		<-ctx.Done()
+
+		if !imageBuilderImageCreateInterrupted(ctx) {
+			t.Errorf("expected interrupted")
+		}
+	})
+
+	t.Run("timed out", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		<-ctx.Done()
+
+		if imageBuilderImageCreateInterrupted(ctx) {
+			t.Errorf("expected timeout not to be treated as interrupted")
+		}
+	})
+}
+
+func TestImageBuilderImageFailureReason(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		State    *imagebuilder.ImageState
+		Expected string
+	}{
+		{
+			TestName: "no state",
+		},
+		{
+			TestName: "available",
+			State: &imagebuilder.ImageState{
+				Status: aws.String(imagebuilder.ImageStatusAvailable),
+			},
+		},
+		{
+			TestName: "building with reason",
+			State: &imagebuilder.ImageState{
+				Reason: aws.String("Executing build phase"),
+				Status: aws.String(imagebuilder.ImageStatusBuilding),
+			},
+		},
+		{
+			TestName: "cancelled",
+			State: &imagebuilder.ImageState{
+				Reason: aws.String("Image creation cancelled by user"),
+				Status: aws.String(imagebuilder.ImageStatusCancelled),
+			},
+			Expected: "Image creation cancelled by user",
+		},
+		{
+			TestName: "failed",
+			State: &imagebuilder.ImageState{
+				Reason: aws.String("Image build failed in the testing phase"),
+				Status: aws.String(imagebuilder.ImageStatusFailed),
+			},
+			Expected: "Image build failed in the testing phase",
+		},
+		{
+			TestName: "failed without reason",
+			State: &imagebuilder.ImageState{
+				Status: aws.String(imagebuilder.ImageStatusFailed),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := imageBuilderImageFailureReason(testCase.State); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestImageBuilderImageFailedComponent(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Reason   string
+		Expected string
+	}{
+		{
+			TestName: "empty",
+		},
+		{
+			TestName: "no component",
+			Reason:   "Image build failed in the testing phase",
+		},
+		{
+			TestName: "amazon managed component",
+			Reason:   "Build failed: Document arn:aws:imagebuilder:us-west-2:aws:component/update-linux/1.0.2/1 failed!",
+			Expected: "arn:aws:imagebuilder:us-west-2:aws:component/update-linux/1.0.2/1",
+		},
+		{
+			TestName: "account component quoted",
+			Reason:   "Test failed: Component 'arn:aws:imagebuilder:us-east-1:123456789012:component/my-tests/1.0.0/3' failed in phase 'validate'",
+			Expected: "arn:aws:imagebuilder:us-east-1:123456789012:component/my-tests/1.0.0/3",
+		},
+		{
+			TestName: "component without build version",
+			Reason:   "Component arn:aws-us-gov:imagebuilder:us-gov-west-1:123456789012:component/hardening/2.1.0, step InstallPackages failed.",
+			Expected: "arn:aws-us-gov:imagebuilder:us-gov-west-1:123456789012:component/hardening/2.1.0",
+		},
+		{
+			TestName: "first of multiple components",
+			Reason:   "arn:aws:imagebuilder:us-east-1:123456789012:component/first/1.0.0/1 failed; arn:aws:imagebuilder:us-east-1:123456789012:component/second/1.0.0/1 skipped",
+			Expected: "arn:aws:imagebuilder:us-east-1:123456789012:component/first/1.0.0/1",
+		},
+		{
+			TestName: "image recipe arn only",
+			Reason:   "Image recipe arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/example/1.0.0 is invalid",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := imageBuilderImageFailedComponent(testCase.Reason); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFlattenImageBuilderAmiArchitectures(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Images   []*ec2.Image
+		Expected map[string]string
+	}{
+		{
+			TestName: "no images",
+			Expected: map[string]string{},
+		},
+		{
+			TestName: "multiple architectures",
+			Images: []*ec2.Image{
+				{
+					Architecture: aws.String(ec2.ArchitectureValuesX8664),
+					ImageId:      aws.String("ami-12345678"),
+				},
+				{
+					Architecture: aws.String(ec2.ArchitectureValuesArm64),
+					ImageId:      aws.String("ami-87654321"),
+				},
+			},
+			Expected: map[string]string{
+				"ami-12345678": ec2.ArchitectureValuesX8664,
+				"ami-87654321": ec2.ArchitectureValuesArm64,
+			},
+		},
+		{
+			TestName: "missing values",
+			Images: []*ec2.Image{
+				nil,
+				{
+					ImageId: aws.String("ami-12345678"),
+				},
+				{
+					Architecture: aws.String(ec2.ArchitectureValuesX8664),
+				},
+				{
+					Architecture: aws.String(ec2.ArchitectureValuesX8664),
+					ImageId:      aws.String("ami-87654321"),
+				},
+			},
+			Expected: map[string]string{
+				"ami-87654321": ec2.ArchitectureValuesX8664,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := flattenImageBuilderAmiArchitectures(testCase.Images)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFlattenImageBuilderAmiFootprint(t *testing.T) {
+	testCases := []struct {
+		TestName              string
+		Images                []*ec2.Image
+		ExpectedAmiCount      int
+		ExpectedSnapshotCount int
+		ExpectedVolumeSize    int
+	}{
+		{
+			TestName: "no images",
+		},
+		{
+			TestName: "single image",
+			Images: []*ec2.Image{
+				{
+					ImageId: aws.String("ami-12345678"),
+					BlockDeviceMappings: []*ec2.BlockDeviceMapping{
+						{
+							DeviceName: aws.String("/dev/xvda"),
+							Ebs: &ec2.EbsBlockDevice{
+								SnapshotId: aws.String("snap-11111111"),
+								VolumeSize: aws.Int64(8),
+							},
+						},
+					},
+				},
+			},
+			ExpectedAmiCount:      1,
+			ExpectedSnapshotCount: 1,
+			ExpectedVolumeSize:    8,
+		},
+		{
+			TestName: "multiple images and volumes",
+			Images: []*ec2.Image{
+				{
+					ImageId: aws.String("ami-12345678"),
+					BlockDeviceMappings: []*ec2.BlockDeviceMapping{
+						{
+							DeviceName: aws.String("/dev/xvda"),
+							Ebs: &ec2.EbsBlockDevice{
+								SnapshotId: aws.String("snap-11111111"),
+								VolumeSize: aws.Int64(8),
+							},
+						},
+						{
+							DeviceName: aws.String("/dev/xvdb"),
+							Ebs: &ec2.EbsBlockDevice{
+								SnapshotId: aws.String("snap-22222222"),
+								VolumeSize: aws.Int64(100),
+							},
+						},
+					},
+				},
+				{
+					ImageId: aws.String("ami-87654321"),
+					BlockDeviceMappings: []*ec2.BlockDeviceMapping{
+						{
+							DeviceName: aws.String("/dev/sda1"),
+							Ebs: &ec2.EbsBlockDevice{
+								SnapshotId: aws.String("snap-33333333"),
+								VolumeSize: aws.Int64(30),
+							},
+						},
+					},
+				},
+			},
+			ExpectedAmiCount:      2,
+			ExpectedSnapshotCount: 3,
+			ExpectedVolumeSize:    138,
+		},
+		{
+			TestName: "ephemeral and empty volumes",
+			Images: []*ec2.Image{
+				nil,
+				{
+					ImageId: aws.String("ami-12345678"),
+					BlockDeviceMappings: []*ec2.BlockDeviceMapping{
+						nil,
+						{
+							DeviceName: aws.String("/dev/xvda"),
+							Ebs: &ec2.EbsBlockDevice{
+								SnapshotId: aws.String("snap-11111111"),
+								VolumeSize: aws.Int64(8),
+							},
+						},
+						{
+							DeviceName:  aws.String("/dev/sdb"),
+							VirtualName: aws.String("ephemeral0"),
+						},
+						{
+							DeviceName: aws.String("/dev/xvdc"),
+							Ebs: &ec2.EbsBlockDevice{
+								VolumeSize: aws.Int64(50),
+							},
+						},
+					},
+				},
+			},
+			ExpectedAmiCount:      1,
+			ExpectedSnapshotCount: 1,
+			ExpectedVolumeSize:    8,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := flattenImageBuilderAmiFootprint(testCase.Images)
+
+			if v := got["ami_count"].(int); v != testCase.ExpectedAmiCount {
+				t.Errorf("got ami_count %d, expected %d", v, testCase.ExpectedAmiCount)
+			}
+
+			if v := got["snapshot_count"].(int); v != testCase.ExpectedSnapshotCount {
+				t.Errorf("got snapshot_count %d, expected %d", v, testCase.ExpectedSnapshotCount)
+			}
+
+			if v := got["total_volume_size"].(int); v != testCase.ExpectedVolumeSize {
+				t.Errorf("got total_volume_size %d, expected %d", v, testCase.ExpectedVolumeSize)
+			}
+		})
+	}
+}
+
+func TestFlattenImageBuilderTargetRepositories(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Distributions []*imagebuilder.Distribution
+		Expected      []interface{}
+	}{
+		{
+			TestName: "no distributions",
+		},
+		{
+			TestName: "ami distribution only",
+			Distributions: []*imagebuilder.Distribution{
+				{
+					AmiDistributionConfiguration: &imagebuilder.AmiDistributionConfiguration{},
+					Region:                       aws.String("us-east-1"),
+				},
+			},
+		},
+		{
+			TestName: "container distributions",
+			Distributions: []*imagebuilder.Distribution{
+				nil,
+				{
+					ContainerDistributionConfiguration: &imagebuilder.ContainerDistributionConfiguration{
+						TargetRepository: &imagebuilder.TargetContainerRepository{
+							RepositoryName: aws.String("example"),
+							Service:        aws.String(imagebuilder.ContainerRepositoryServiceEcr),
+						},
+					},
+					Region: aws.String("us-east-1"),
+				},
+				{
+					AmiDistributionConfiguration: &imagebuilder.AmiDistributionConfiguration{},
+					Region:                       aws.String("us-east-2"),
+				},
+				{
+					ContainerDistributionConfiguration: &imagebuilder.ContainerDistributionConfiguration{
+						TargetRepository: &imagebuilder.TargetContainerRepository{
+							RepositoryName: aws.String("team/example"),
+							Service:        aws.String(imagebuilder.ContainerRepositoryServiceEcr),
+						},
+					},
+					Region: aws.String("us-west-2"),
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"region":          "us-east-1",
+					"repository_name": "example",
+					"service":         imagebuilder.ContainerRepositoryServiceEcr,
+				},
+				map[string]interface{}{
+					"region":          "us-west-2",
+					"repository_name": "team/example",
+					"service":         imagebuilder.ContainerRepositoryServiceEcr,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := flattenImageBuilderTargetRepositories(testCase.Distributions)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestImageBuilderContainerImageUriRepositoryAndTag(t *testing.T) {
+	testCases := []struct {
+		TestName           string
+		Uri                string
+		ExpectedRepository string
+		ExpectedTag        string
+	}{
+		{
+			TestName: "empty",
+		},
+		{
+			TestName:           "ecr tag",
+			Uri:                "123456789012.dkr.ecr.us-east-1.amazonaws.com/example:1.0.0-1",
+			ExpectedRepository: "123456789012.dkr.ecr.us-east-1.amazonaws.com/example",
+			ExpectedTag:        "1.0.0-1",
+		},
+		{
+			TestName:           "ecr nested repository",
+			Uri:                "123456789012.dkr.ecr.us-east-1.amazonaws.com/team/example:latest",
+			ExpectedRepository: "123456789012.dkr.ecr.us-east-1.amazonaws.com/team/example",
+			ExpectedTag:        "latest",
+		},
+		{
+			TestName:           "ecr digest",
+			Uri:                "123456789012.dkr.ecr.us-east-1.amazonaws.com/example@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+			ExpectedRepository: "123456789012.dkr.ecr.us-east-1.amazonaws.com/example",
+		},
+		{
+			TestName:           "no tag",
+			Uri:                "123456789012.dkr.ecr.us-east-1.amazonaws.com/example",
+			ExpectedRepository: "123456789012.dkr.ecr.us-east-1.amazonaws.com/example",
+		},
+		{
+			TestName:           "registry port without tag",
+			Uri:                "registry.example.com:5000/example",
+			ExpectedRepository: "registry.example.com:5000/example",
+		},
+		{
+			TestName:           "registry port with tag",
+			Uri:                "registry.example.com:5000/example:1.0.0",
+			ExpectedRepository: "registry.example.com:5000/example",
+			ExpectedTag:        "1.0.0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			repository, tag := imageBuilderContainerImageUriRepositoryAndTag(testCase.Uri)
+
+			if repository != testCase.ExpectedRepository {
+				t.Errorf("got repository %s, expected %s", repository, testCase.ExpectedRepository)
+			}
+
+			if tag != testCase.ExpectedTag {
+				t.Errorf("got tag %s, expected %s", tag, testCase.ExpectedTag)
+			}
+		})
+	}
+}
+
 func TestAccAwsImageBuilderImage_basic(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	imageRecipeResourceName := "aws_imagebuilder_image_recipe.test"
@@ -92,32 +1001,235 @@ func TestAccAwsImageBuilderImage_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsImageBuilderImageExists(resourceName),
 					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "imagebuilder", regexp.MustCompile(fmt.Sprintf("image/%s/1.0.0/[1-9][0-9]*", rName))),
+					resource.TestCheckResourceAttr(resourceName, "build_region", testAccGetRegion()),
 					testAccCheckResourceAttrRfc3339(resourceName, "date_created"),
 					resource.TestCheckNoResourceAttr(resourceName, "distribution_configuration_arn"),
+					resource.TestCheckResourceAttr(resourceName, "distribution_configuration_name", ""),
 					resource.TestCheckResourceAttr(resourceName, "enhanced_image_metadata_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "failed_component", ""),
+					resource.TestCheckResourceAttr(resourceName, "failure_reason", ""),
 					resource.TestCheckResourceAttrPair(resourceName, "image_recipe_arn", imageRecipeResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "image_recipe_name", imageRecipeResourceName, "name"),
 					resource.TestCheckResourceAttr(resourceName, "image_tests_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "image_tests_configuration.0.image_tests_enabled", "true"),
 					resource.TestCheckResourceAttr(resourceName, "image_tests_configuration.0.timeout_minutes", "720"),
 					resource.TestCheckResourceAttrPair(resourceName, "infrastructure_configuration_arn", infrastructureConfigurationResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "infrastructure_configuration_name", infrastructureConfigurationResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "logs_uri", ""),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "platform", imagebuilder.PlatformLinux),
 					resource.TestCheckResourceAttr(resourceName, "os_version", "Amazon Linux 2"),
 					resource.TestCheckResourceAttr(resourceName, "output_resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output_resources.0.amis.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output_resources.0.containers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "total_ami_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "total_container_count", "0"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "recipe_arn_base", "imagebuilder", regexp.MustCompile(fmt.Sprintf("image-recipe/%s$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "recipe_components.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "recipe_components.0", regexp.MustCompile(`component/update-linux/`)),
+					resource.TestCheckResourceAttr(resourceName, "semantic_version", "1.0.0"),
+					resource.TestCheckResourceAttr(resourceName, "source_pipeline_arn", ""),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 					resource.TestMatchResourceAttr(resourceName, "version", regexp.MustCompile(`1.0.0/[1-9][0-9]*`)),
+					resource.TestMatchResourceAttr(resourceName, "build_number", regexp.MustCompile(`^[1-9][0-9]*$`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderImage_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_imagebuilder_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImageConfigRequired(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsImageBuilderImage(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderImage_DistributionConfigurationArn(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	distributionConfigurationResourceName := "aws_imagebuilder_distribution_configuration.test"
+	resourceName := "aws_imagebuilder_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImageConfigDistributionConfigurationArn(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "distribution_configuration_arn", distributionConfigurationResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "distribution_configuration_name", distributionConfigurationResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "target_repositories.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderImage_DistributionConfigurationArn_Partition(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	// Mix the current partition with a distribution configuration ARN from another one.
+	partition := endpoints.AwsUsGovPartitionID
+	region := endpoints.UsGovWest1RegionID
+
+	if testAccGetPartition() == endpoints.AwsUsGovPartitionID {
+		partition = endpoints.AwsPartitionID
+		region = endpoints.UsEast1RegionID
+	}
+
+	distributionConfigurationArn := fmt.Sprintf("arn:%s:imagebuilder:%s:123456789012:distribution-configuration/%s", partition, region, rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsImageBuilderImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsImageBuilderImageConfigDistributionConfigurationArnValue(rName, distributionConfigurationArn),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`expected partition %s in ARN \(arn:%s:.+\), got: %s`, testAccGetPartition(), partition, partition)),
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderImage_EnhancedImageMetadataEnabled(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_imagebuilder_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImageConfigEnhancedImageMetadataEnabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_image_metadata_enabled", "false"),
+				),
+			},
+			{
+				Config:   testAccAwsImageBuilderImageConfigEnhancedImageMetadataEnabled(rName, false),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderImage_ImageTestsConfiguration_ImageTestsEnabled(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_imagebuilder_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImageConfigImageTestsConfigurationImageTestsEnabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "image_tests_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "image_tests_configuration.0.image_tests_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderImage_ImageTestsConfiguration_TimeoutMinutes(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_imagebuilder_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImageConfigImageTestsConfigurationTimeoutMinutes(rName, 721),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "image_tests_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "image_tests_configuration.0.timeout_minutes", "721"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderImage_ResolveAmiKmsKeyIds(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	kmsKeyResourceName := "aws_kms_key.test"
+	resourceName := "aws_imagebuilder_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImageConfigResolveAmiKmsKeyIds(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resolve_ami_kms_key_ids", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ami_kms_key_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "ami_kms_key_ids.*.kms_key_id", kmsKeyResourceName, "arn"),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ami_kms_key_ids", "resolve_ami_kms_key_ids"},
 			},
 		},
 	})
 }
 
-func TestAccAwsImageBuilderImage_disappears(t *testing.T) {
+func TestAccAwsImageBuilderImage_ResolveAmiArchitectures(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_imagebuilder_image.test"
 
@@ -127,20 +1239,34 @@ func TestAccAwsImageBuilderImage_disappears(t *testing.T) {
 		CheckDestroy: testAccCheckAwsImageBuilderImageDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAwsImageBuilderImageConfigRequired(rName),
+				Config: testAccAwsImageBuilderImageConfigResolveAmiArchitectures(rName, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsImageBuilderImageExists(resourceName),
-					testAccCheckResourceDisappears(testAccProvider, resourceAwsImageBuilderImage(), resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resolve_ami_architectures", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ami_architectures.%", "1"),
+					testAccCheckAwsImageBuilderImageAmiArchitecture(resourceName, ec2.ArchitectureValuesX8664),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ami_architectures", "resolve_ami_architectures"},
+			},
+			{
+				Config: testAccAwsImageBuilderImageConfigResolveAmiArchitectures(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resolve_ami_architectures", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ami_architectures.%", "0"),
 				),
-				ExpectNonEmptyPlan: true,
 			},
 		},
 	})
 }
 
-func TestAccAwsImageBuilderImage_DistributionConfigurationArn(t *testing.T) {
+func TestAccAwsImageBuilderImage_ResolveAmiFootprint(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
-	distributionConfigurationResourceName := "aws_imagebuilder_distribution_configuration.test"
 	resourceName := "aws_imagebuilder_image.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -149,22 +1275,35 @@ func TestAccAwsImageBuilderImage_DistributionConfigurationArn(t *testing.T) {
 		CheckDestroy: testAccCheckAwsImageBuilderImageDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAwsImageBuilderImageConfigDistributionConfigurationArn(rName),
+				Config: testAccAwsImageBuilderImageConfigResolveAmiFootprint(rName, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsImageBuilderImageExists(resourceName),
-					resource.TestCheckResourceAttrPair(resourceName, "distribution_configuration_arn", distributionConfigurationResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "resolve_ami_footprint", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ami_footprint.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ami_footprint.0.ami_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ami_footprint.0.snapshot_count", "1"),
+					resource.TestMatchResourceAttr(resourceName, "ami_footprint.0.total_volume_size", regexp.MustCompile(`^[1-9][0-9]*$`)),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ami_footprint", "resolve_ami_footprint"},
+			},
+			{
+				Config: testAccAwsImageBuilderImageConfigResolveAmiFootprint(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resolve_ami_footprint", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ami_footprint.#", "0"),
+				),
 			},
 		},
 	})
 }
 
-func TestAccAwsImageBuilderImage_EnhancedImageMetadataEnabled(t *testing.T) {
+func TestAccAwsImageBuilderImage_ResolveAmiSnapshotIds(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_imagebuilder_image.test"
 
@@ -174,22 +1313,36 @@ func TestAccAwsImageBuilderImage_EnhancedImageMetadataEnabled(t *testing.T) {
 		CheckDestroy: testAccCheckAwsImageBuilderImageDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAwsImageBuilderImageConfigEnhancedImageMetadataEnabled(rName, false),
+				Config: testAccAwsImageBuilderImageConfigResolveAmiSnapshotIds(rName, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsImageBuilderImageExists(resourceName),
-					resource.TestCheckResourceAttr(resourceName, "enhanced_image_metadata_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "resolve_ami_snapshot_ids", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ami_snapshot_ids.#", "1"),
+					resource.TestMatchTypeSetElemNestedAttrs(resourceName, "ami_snapshot_ids.*", map[string]*regexp.Regexp{
+						"image":          regexp.MustCompile(`^ami-[0-9a-f]+$`),
+						"snapshot_ids.#": regexp.MustCompile(`^1$`),
+					}),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ami_snapshot_ids", "resolve_ami_snapshot_ids"},
+			},
+			{
+				Config: testAccAwsImageBuilderImageConfigResolveAmiSnapshotIds(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resolve_ami_snapshot_ids", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ami_snapshot_ids.#", "0"),
+				),
 			},
 		},
 	})
 }
 
-func TestAccAwsImageBuilderImage_ImageTestsConfiguration_ImageTestsEnabled(t *testing.T) {
+func TestAccAwsImageBuilderImage_ResolveEffectiveAmiTags(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_imagebuilder_image.test"
 
@@ -199,43 +1352,54 @@ func TestAccAwsImageBuilderImage_ImageTestsConfiguration_ImageTestsEnabled(t *te
 		CheckDestroy: testAccCheckAwsImageBuilderImageDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAwsImageBuilderImageConfigImageTestsConfigurationImageTestsEnabled(rName, false),
+				Config: testAccAwsImageBuilderImageConfigResolveEffectiveAmiTags(rName, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsImageBuilderImageExists(resourceName),
-					resource.TestCheckResourceAttr(resourceName, "image_tests_configuration.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "image_tests_configuration.0.image_tests_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "resolve_effective_ami_tags", "true"),
+					resource.TestCheckResourceAttr(resourceName, "effective_ami_tags.key1", "value1"),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"effective_ami_tags", "resolve_effective_ami_tags"},
+			},
+			{
+				Config: testAccAwsImageBuilderImageConfigResolveEffectiveAmiTags(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resolve_effective_ami_tags", "false"),
+					resource.TestCheckResourceAttr(resourceName, "effective_ami_tags.%", "0"),
+				),
 			},
 		},
 	})
 }
 
-func TestAccAwsImageBuilderImage_ImageTestsConfiguration_TimeoutMinutes(t *testing.T) {
+func TestAccAwsImageBuilderImage_TagAmisWithRecipeVersion(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
+	amiDataSourceName := "data.aws_ami.test"
 	resourceName := "aws_imagebuilder_image.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckAwsImageBuilderImageDestroy,
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsImageBuilderImageDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAwsImageBuilderImageConfigImageTestsConfigurationTimeoutMinutes(rName, 721),
+				Config: testAccAwsImageBuilderImageConfigTagAmisWithRecipeVersion(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsImageBuilderImageExists(resourceName),
-					resource.TestCheckResourceAttr(resourceName, "image_tests_configuration.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "image_tests_configuration.0.timeout_minutes", "721"),
+					resource.TestCheckResourceAttr(resourceName, "tag_amis_with_recipe_version", "true"),
+					resource.TestCheckResourceAttr(amiDataSourceName, "tags.SourceRecipeVersion", "1.0.0"),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"tag_amis_with_recipe_version"},
 			},
 		},
 	})
@@ -284,66 +1448,335 @@ func TestAccAwsImageBuilderImage_Tags(t *testing.T) {
 	})
 }
 
+func TestAccAwsImageBuilderImage_AlternateRegion(t *testing.T) {
+	var providers []*schema.Provider
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_imagebuilder_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionPreCheck(t, 2)
+		},
+		ProviderFactories: testAccProviderFactoriesMultipleRegion(&providers, 2),
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckAwsImageBuilderImageDestroyWithProvider(s, testAccAwsRegionProviderFunc(testAccGetAlternateRegion(), &providers)())
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImageConfigAlternateRegion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageExistsWithProvider(resourceName, testAccAwsRegionProviderFunc(testAccGetAlternateRegion(), &providers)),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf(`^arn:[^:]+:imagebuilder:%s:\d{12}:image/%s/1.0.0/[1-9][0-9]*$`, testAccGetAlternateRegion(), rName))),
+					resource.TestCheckResourceAttr(resourceName, "output_resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output_resources.0.amis.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "output_resources.0.amis.*", map[string]string{
+						"region": testAccGetAlternateRegion(),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderImage_ResolveLogsEncrypted(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_imagebuilder_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImageConfigResolveLogsEncrypted(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resolve_logs_encrypted", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logs_encrypted", "true"),
+					resource.TestMatchResourceAttr(resourceName, "logs_uri", regexp.MustCompile(fmt.Sprintf(`^s3://%s/%s/1\.0\.0/[1-9][0-9]*/$`, rName, rName))),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"logs_encrypted", "resolve_logs_encrypted"},
+			},
+			{
+				Config: testAccAwsImageBuilderImageConfigResolveLogsEncrypted(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resolve_logs_encrypted", "false"),
+					resource.TestCheckResourceAttr(resourceName, "logs_encrypted", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderImage_EmitEventbridgeEvent(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	eventBusResourceName := "aws_cloudwatch_event_bus.test"
+	resourceName := "aws_imagebuilder_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImageConfigEmitEventbridgeEvent(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "emit_eventbridge_event.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "emit_eventbridge_event.0.detail_type", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "emit_eventbridge_event.0.event_bus_name", eventBusResourceName, "name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"emit_eventbridge_event"},
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderImage_OsVersion_Windows(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_imagebuilder_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderImageConfigOsVersionWindows(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderImageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "platform", imagebuilder.PlatformWindows),
+					resource.TestMatchResourceAttr(resourceName, "os_version", regexp.MustCompile(`^Microsoft Windows Server 2019`)),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAwsImageBuilderImageDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+	return testAccCheckAwsImageBuilderImageDestroyWithProvider(s, testAccProvider)
+}
+
+func testAccCheckAwsImageBuilderImageDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	conn := provider.Meta().(*AWSClient).imagebuilderconn
 
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "aws_imagebuilder_image_pipeline" {
+		if rs.Type != "aws_imagebuilder_image" {
+			continue
+		}
+
+		input := &imagebuilder.GetImageInput{
+			ImageBuildVersionArn: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.GetImage(input)
+
+		if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
 			continue
 		}
 
-		input := &imagebuilder.GetImageInput{
-			ImageBuildVersionArn: aws.String(rs.Primary.ID),
-		}
+		if err != nil {
+			return fmt.Errorf("error getting Image Builder Image (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output != nil {
+			return fmt.Errorf("Image Builder Image (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsImageBuilderImageExists(resourceName string) resource.TestCheckFunc {
+	return testAccCheckAwsImageBuilderImageExistsWithProvider(resourceName, func() *schema.Provider { return testAccProvider })
+}
+
+func testAccCheckAwsImageBuilderImageExistsWithProvider(resourceName string, providerF func() *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := providerF().Meta().(*AWSClient).imagebuilderconn
+
+		input := &imagebuilder.GetImageInput{
+			ImageBuildVersionArn: aws.String(rs.Primary.ID),
+		}
+
+		_, err := conn.GetImage(input)
+
+		if err != nil {
+			return fmt.Errorf("error getting Image Builder Image (%s): %w", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckAwsImageBuilderImageAmiArchitecture verifies that each output AMI in ami_architectures
+// is reported with the expected architecture.
+func testAccCheckAwsImageBuilderImageAmiArchitecture(resourceName string, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		var count int
+
+		for k, v := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "output_resources.0.amis.") || !strings.HasSuffix(k, ".image") {
+				continue
+			}
+
+			count++
+
+			if got := rs.Primary.Attributes[fmt.Sprintf("ami_architectures.%s", v)]; got != expected {
+				return fmt.Errorf("Image Builder Image (%s) AMI (%s) architecture: got %q, expected %q", rs.Primary.ID, v, got, expected)
+			}
+		}
+
+		if count == 0 {
+			return fmt.Errorf("Image Builder Image (%s) has no output AMIs", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsImageBuilderImageConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_imagebuilder_component" "update-linux" {
+  arn = "arn:${data.aws_partition.current.partition}:imagebuilder:${data.aws_region.current.name}:aws:component/update-linux/1.0.0"
+}
+
+data "aws_region" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_instance_profile" "test" {
+  name = aws_iam_role.test.name
+  role = aws_iam_role.test.name
+
+  depends_on = [
+    aws_iam_role_policy_attachment.AmazonSSMManagedInstanceCore,
+    aws_iam_role_policy_attachment.EC2InstanceProfileForImageBuilder,
+  ]
+}
+
+resource "aws_iam_role" "test" {
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+      Sid = ""
+    }]
+  })
+  name = %[1]q
+}
+
+resource "aws_iam_role_policy_attachment" "AmazonSSMManagedInstanceCore" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonSSMManagedInstanceCore"
+  role       = aws_iam_role.test.name
+}
+
+resource "aws_iam_role_policy_attachment" "EC2InstanceProfileForImageBuilder" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/EC2InstanceProfileForImageBuilder"
+  role       = aws_iam_role.test.name
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+}
 
-		output, err := conn.GetImage(input)
+resource "aws_default_route_table" "test" {
+  default_route_table_id = aws_vpc.test.default_route_table_id
 
-		if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
-			continue
-		}
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = aws_internet_gateway.test.id
+  }
+}
 
-		if err != nil {
-			return fmt.Errorf("error getting Image Builder Image (%s): %w", rs.Primary.ID, err)
-		}
+resource "aws_default_security_group" "test" {
+  vpc_id = aws_vpc.test.id
 
-		if output != nil {
-			return fmt.Errorf("Image Builder Image (%s) still exists", rs.Primary.ID)
-		}
-	}
+  egress {
+    cidr_blocks = ["0.0.0.0/0"]
+    from_port   = 0
+    protocol    = "-1"
+    to_port     = 0
+  }
 
-	return nil
+  ingress {
+    from_port = 0
+    protocol  = -1
+    self      = true
+    to_port   = 0
+  }
 }
 
-func testAccCheckAwsImageBuilderImageExists(resourceName string) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[resourceName]
-		if !ok {
-			return fmt.Errorf("resource not found: %s", resourceName)
-		}
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+}
 
-		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+resource "aws_subnet" "test" {
+  cidr_block              = cidrsubnet(aws_vpc.test.cidr_block, 8, 0)
+  map_public_ip_on_launch = true
+  vpc_id                  = aws_vpc.test.id
+}
 
-		input := &imagebuilder.GetImageInput{
-			ImageBuildVersionArn: aws.String(rs.Primary.ID),
-		}
+resource "aws_imagebuilder_image_recipe" "test" {
+  component {
+    component_arn = data.aws_imagebuilder_component.update-linux.arn
+  }
 
-		_, err := conn.GetImage(input)
+  name         = %[1]q
+  parent_image = "arn:${data.aws_partition.current.partition}:imagebuilder:${data.aws_region.current.name}:aws:image/amazon-linux-2-x86/x.x.x"
+  version      = "1.0.0"
+}
 
-		if err != nil {
-			return fmt.Errorf("error getting Image Builder Image (%s): %w", rs.Primary.ID, err)
-		}
+resource "aws_imagebuilder_infrastructure_configuration" "test" {
+  instance_profile_name = aws_iam_instance_profile.test.name
+  name                  = %[1]q
+  security_group_ids    = [aws_default_security_group.test.id]
+  subnet_id             = aws_subnet.test.id
 
-		return nil
-	}
+  depends_on = [aws_default_route_table.test]
+}
+`, rName)
 }
 
-func testAccAwsImageBuilderImageConfigBase(rName string) string {
-	return fmt.Sprintf(`
+func testAccAwsImageBuilderImageConfigAlternateRegion(rName string) string {
+	return composeConfig(
+		testAccMultipleRegionProviderConfig(2),
+		fmt.Sprintf(`
 data "aws_imagebuilder_component" "update-linux" {
-  arn = "arn:${data.aws_partition.current.partition}:imagebuilder:${data.aws_region.current.name}:aws:component/update-linux/1.0.0"
+  provider = awsalternate
+
+  arn = "arn:${data.aws_partition.current.partition}:imagebuilder:${data.aws_region.alternate.name}:aws:component/update-linux/1.0.0"
 }
 
-data "aws_region" "current" {}
+data "aws_region" "alternate" {
+  provider = awsalternate
+}
 
 data "aws_partition" "current" {}
 
@@ -383,10 +1816,14 @@ resource "aws_iam_role_policy_attachment" "EC2InstanceProfileForImageBuilder" {
 }
 
 resource "aws_vpc" "test" {
+  provider = awsalternate
+
   cidr_block = "10.0.0.0/16"
 }
 
 resource "aws_default_route_table" "test" {
+  provider = awsalternate
+
   default_route_table_id = aws_vpc.test.default_route_table_id
 
   route {
@@ -396,6 +1833,8 @@ resource "aws_default_route_table" "test" {
 }
 
 resource "aws_default_security_group" "test" {
+  provider = awsalternate
+
   vpc_id = aws_vpc.test.id
 
   egress {
@@ -414,26 +1853,34 @@ resource "aws_default_security_group" "test" {
 }
 
 resource "aws_internet_gateway" "test" {
+  provider = awsalternate
+
   vpc_id = aws_vpc.test.id
 }
 
 resource "aws_subnet" "test" {
+  provider = awsalternate
+
   cidr_block              = cidrsubnet(aws_vpc.test.cidr_block, 8, 0)
   map_public_ip_on_launch = true
   vpc_id                  = aws_vpc.test.id
 }
 
 resource "aws_imagebuilder_image_recipe" "test" {
+  provider = awsalternate
+
   component {
     component_arn = data.aws_imagebuilder_component.update-linux.arn
   }
 
   name         = %[1]q
-  parent_image = "arn:${data.aws_partition.current.partition}:imagebuilder:${data.aws_region.current.name}:aws:image/amazon-linux-2-x86/x.x.x"
+  parent_image = "arn:${data.aws_partition.current.partition}:imagebuilder:${data.aws_region.alternate.name}:aws:image/amazon-linux-2-x86/x.x.x"
   version      = "1.0.0"
 }
 
 resource "aws_imagebuilder_infrastructure_configuration" "test" {
+  provider = awsalternate
+
   instance_profile_name = aws_iam_instance_profile.test.name
   name                  = %[1]q
   security_group_ids    = [aws_default_security_group.test.id]
@@ -441,7 +1888,14 @@ resource "aws_imagebuilder_infrastructure_configuration" "test" {
 
   depends_on = [aws_default_route_table.test]
 }
-`, rName)
+
+resource "aws_imagebuilder_image" "test" {
+  provider = awsalternate
+
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+}
+`, rName))
 }
 
 func testAccAwsImageBuilderImageConfigDistributionConfigurationArn(rName string) string {
@@ -468,6 +1922,18 @@ resource "aws_imagebuilder_image" "test" {
 `, rName))
 }
 
+func testAccAwsImageBuilderImageConfigDistributionConfigurationArnValue(rName string, distributionConfigurationArn string) string {
+	return composeConfig(
+		testAccAwsImageBuilderImageConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_imagebuilder_image" "test" {
+  distribution_configuration_arn   = %[1]q
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+}
+`, distributionConfigurationArn))
+}
+
 func testAccAwsImageBuilderImageConfigEnhancedImageMetadataEnabled(rName string, enhancedImageMetadataEnabled bool) string {
 	return composeConfig(
 		testAccAwsImageBuilderImageConfigBase(rName),
@@ -521,6 +1987,207 @@ resource "aws_imagebuilder_image" "test" {
 `)
 }
 
+func testAccAwsImageBuilderImageConfigResolveAmiKmsKeyIds(rName string) string {
+	return composeConfig(
+		testAccAwsImageBuilderImageConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+}
+
+resource "aws_imagebuilder_distribution_configuration" "test" {
+  name = %[1]q
+
+  distribution {
+    ami_distribution_configuration {
+      kms_key_id = aws_kms_key.test.arn
+      name       = "{{ imagebuilder:buildDate }}"
+    }
+
+    region = data.aws_region.current.name
+  }
+}
+
+resource "aws_imagebuilder_image" "test" {
+  distribution_configuration_arn   = aws_imagebuilder_distribution_configuration.test.arn
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+  resolve_ami_kms_key_ids          = true
+}
+`, rName))
+}
+
+func testAccAwsImageBuilderImageConfigEmitEventbridgeEvent(rName string) string {
+	return composeConfig(
+		testAccAwsImageBuilderImageConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+resource "aws_imagebuilder_image" "test" {
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+
+  emit_eventbridge_event {
+    detail_type    = %[1]q
+    event_bus_name = aws_cloudwatch_event_bus.test.name
+  }
+}
+`, rName))
+}
+
+func testAccAwsImageBuilderImageConfigOsVersionWindows(rName string) string {
+	return composeConfig(
+		testAccAwsImageBuilderImageConfigBase(rName),
+		fmt.Sprintf(`
+data "aws_imagebuilder_component" "update-windows" {
+  arn = "arn:${data.aws_partition.current.partition}:imagebuilder:${data.aws_region.current.name}:aws:component/update-windows/1.0.0"
+}
+
+resource "aws_imagebuilder_image_recipe" "windows" {
+  component {
+    component_arn = data.aws_imagebuilder_component.update-windows.arn
+  }
+
+  name         = "%[1]s-windows"
+  parent_image = "arn:${data.aws_partition.current.partition}:imagebuilder:${data.aws_region.current.name}:aws:image/windows-server-2019-english-full-base-x86/x.x.x"
+  version      = "1.0.0"
+}
+
+resource "aws_imagebuilder_image" "test" {
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.windows.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+}
+`, rName))
+}
+
+func testAccAwsImageBuilderImageConfigResolveAmiArchitectures(rName string, resolveAmiArchitectures bool) string {
+	return composeConfig(
+		testAccAwsImageBuilderImageConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_imagebuilder_image" "test" {
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+  resolve_ami_architectures        = %[2]t
+}
+`, rName, resolveAmiArchitectures))
+}
+
+func testAccAwsImageBuilderImageConfigResolveAmiFootprint(rName string, resolveAmiFootprint bool) string {
+	return composeConfig(
+		testAccAwsImageBuilderImageConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_imagebuilder_image" "test" {
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+  resolve_ami_footprint            = %[2]t
+}
+`, rName, resolveAmiFootprint))
+}
+
+func testAccAwsImageBuilderImageConfigResolveAmiSnapshotIds(rName string, resolveAmiSnapshotIds bool) string {
+	return composeConfig(
+		testAccAwsImageBuilderImageConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_imagebuilder_image" "test" {
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+  resolve_ami_snapshot_ids         = %[2]t
+}
+`, rName, resolveAmiSnapshotIds))
+}
+
+func testAccAwsImageBuilderImageConfigResolveEffectiveAmiTags(rName string, resolveEffectiveAmiTags bool) string {
+	return composeConfig(
+		testAccAwsImageBuilderImageConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_imagebuilder_distribution_configuration" "test" {
+  name = %[1]q
+
+  distribution {
+    ami_distribution_configuration {
+      ami_tags = {
+        key1 = "value1"
+      }
+
+      name = "{{ imagebuilder:buildDate }}"
+    }
+
+    region = data.aws_region.current.name
+  }
+}
+
+resource "aws_imagebuilder_image" "test" {
+  distribution_configuration_arn   = aws_imagebuilder_distribution_configuration.test.arn
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+  resolve_effective_ami_tags       = %[2]t
+}
+`, rName, resolveEffectiveAmiTags))
+}
+
+func testAccAwsImageBuilderImageConfigResolveLogsEncrypted(rName string, resolveLogsEncrypted bool) string {
+	return composeConfig(
+		testAccAwsImageBuilderImageConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+
+  server_side_encryption_configuration {
+    rule {
+      apply_server_side_encryption_by_default {
+        sse_algorithm = "AES256"
+      }
+    }
+  }
+}
+
+resource "aws_imagebuilder_infrastructure_configuration" "logs" {
+  instance_profile_name = aws_iam_instance_profile.test.name
+  name                  = "%[1]s-logs"
+  security_group_ids    = [aws_default_security_group.test.id]
+  subnet_id             = aws_subnet.test.id
+
+  logging {
+    s3_logs {
+      s3_bucket_name = aws_s3_bucket.test.bucket
+    }
+  }
+
+  depends_on = [aws_default_route_table.test]
+}
+
+resource "aws_imagebuilder_image" "test" {
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.logs.arn
+  resolve_logs_encrypted           = %[2]t
+}
+`, rName, resolveLogsEncrypted))
+}
+
+func testAccAwsImageBuilderImageConfigTagAmisWithRecipeVersion(rName string) string {
+	return composeConfig(
+		testAccAwsImageBuilderImageConfigBase(rName),
+		`
+resource "aws_imagebuilder_image" "test" {
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+  tag_amis_with_recipe_version     = true
+}
+
+data "aws_ami" "test" {
+  owners = ["self"]
+
+  filter {
+    name   = "image-id"
+    values = [tolist(aws_imagebuilder_image.test.output_resources[0].amis)[0].image]
+  }
+}
+`)
+}
+
 func testAccAwsImageBuilderImageConfigTags1(rName string, tagKey1 string, tagValue1 string) string {
 	return composeConfig(
 		testAccAwsImageBuilderImageConfigBase(rName),
@@ -1,28 +1,39 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	iamfinder "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/finder"
 	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsImageBuilderInfrastructureConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsImageBuilderInfrastructureConfigurationCreate,
-		Read:   resourceAwsImageBuilderInfrastructureConfigurationRead,
-		Update: resourceAwsImageBuilderInfrastructureConfigurationUpdate,
-		Delete: resourceAwsImageBuilderInfrastructureConfigurationDelete,
+		CreateContext: resourceAwsImageBuilderInfrastructureConfigurationCreate,
+		ReadContext:   resourceAwsImageBuilderInfrastructureConfigurationRead,
+		UpdateContext: resourceAwsImageBuilderInfrastructureConfigurationUpdate,
+		DeleteContext: resourceAwsImageBuilderInfrastructureConfigurationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -30,6 +41,10 @@ func resourceAwsImageBuilderInfrastructureConfiguration() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"check_subnet_egress": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"date_created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -48,6 +63,10 @@ func resourceAwsImageBuilderInfrastructureConfiguration() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(1, 1024),
 			},
+			"instance_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"instance_types": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -89,9 +108,14 @@ func resourceAwsImageBuilderInfrastructureConfiguration() *schema.Resource {
 				},
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^[-_A-Za-z-0-9][-_A-Za-z0-9 ]{1,126}[-_A-Za-z-0-9]$"), "valid name must be provided"),
+			},
+			"resolve_instance_role_arn": {
+				Type:     schema.TypeBool,
+				Optional: true,
 			},
 			"resource_tags": tagsSchema(),
 			"security_group_ids": {
@@ -116,13 +140,31 @@ func resourceAwsImageBuilderInfrastructureConfiguration() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"validate_instance_profile": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"validate_key_pair": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"validate_security_group_vpc": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"validate_sns_topic": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
 
-func resourceAwsImageBuilderInfrastructureConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsImageBuilderInfrastructureConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).imagebuilderconn
 
+	var diags diag.Diagnostics
+
 	input := &imagebuilder.CreateInfrastructureConfigurationInput{
 		ClientToken: aws.String(resource.UniqueId()),
 	}
@@ -171,15 +213,46 @@ func resourceAwsImageBuilderInfrastructureConfigurationCreate(d *schema.Resource
 		input.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().ImagebuilderTags()
 	}
 
-	if v, ok := d.GetOk("terminate_instance_on_failure"); ok {
-		input.TerminateInstanceOnFailure = aws.Bool(v.(bool))
+	// Always sent, as GetOk cannot distinguish an explicit false from an omitted value.
+	input.TerminateInstanceOnFailure = aws.Bool(d.Get("terminate_instance_on_failure").(bool))
+
+	if d.Get("validate_instance_profile").(bool) {
+		if err := imageBuilderInfrastructureConfigurationValidateInstanceProfile(meta.(*AWSClient).iamconn, aws.StringValue(input.InstanceProfileName)); err != nil {
+			return diag.FromErr(fmt.Errorf("error creating Image Builder Infrastructure Configuration: %w", err))
+		}
+	}
+
+	if d.Get("validate_key_pair").(bool) {
+		if err := imageBuilderInfrastructureConfigurationValidateKeyPair(meta.(*AWSClient).ec2conn, aws.StringValue(input.KeyPair)); err != nil {
+			return diag.FromErr(fmt.Errorf("error creating Image Builder Infrastructure Configuration: %w", err))
+		}
+	}
+
+	if d.Get("validate_security_group_vpc").(bool) {
+		if err := imageBuilderInfrastructureConfigurationValidateSecurityGroupVpc(meta.(*AWSClient).ec2conn, input.SubnetId, input.SecurityGroupIds); err != nil {
+			return diag.FromErr(fmt.Errorf("error creating Image Builder Infrastructure Configuration: %w", err))
+		}
+	}
+
+	if d.Get("validate_sns_topic").(bool) {
+		snsTopicDiags, err := imageBuilderInfrastructureConfigurationValidateSnsTopic(meta.(*AWSClient).snsconn, meta.(*AWSClient).region, aws.StringValue(input.SnsTopicArn))
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error creating Image Builder Infrastructure Configuration: %w", err))
+		}
+
+		diags = append(diags, snsTopicDiags...)
+	}
+
+	if d.Get("check_subnet_egress").(bool) && input.SubnetId != nil {
+		diags = append(diags, imageBuilderInfrastructureConfigurationCheckSubnetEgress(meta.(*AWSClient).ec2conn, aws.StringValue(input.SubnetId))...)
 	}
 
 	var output *imagebuilder.CreateInfrastructureConfigurationOutput
 	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
 		var err error
 
-		output, err = conn.CreateInfrastructureConfiguration(input)
+		output, err = conn.CreateInfrastructureConfigurationWithContext(ctx, input)
 
 		if tfawserr.ErrMessageContains(err, imagebuilder.ErrCodeInvalidParameterValueException, "instance profile does not exist") {
 			return resource.RetryableError(err)
@@ -193,23 +266,23 @@ func resourceAwsImageBuilderInfrastructureConfigurationCreate(d *schema.Resource
 	})
 
 	if tfresource.TimedOut(err) {
-		output, err = conn.CreateInfrastructureConfiguration(input)
+		output, err = conn.CreateInfrastructureConfigurationWithContext(ctx, input)
 	}
 
 	if err != nil {
-		return fmt.Errorf("error creating Image Builder Infrastructure Configuration: %w", err)
+		return append(diags, diag.FromErr(fmt.Errorf("error creating Image Builder Infrastructure Configuration: %w", err))...)
 	}
 
 	if output == nil {
-		return fmt.Errorf("error creating Image Builder Infrastructure Configuration: empty response")
+		return append(diags, diag.FromErr(fmt.Errorf("error creating Image Builder Infrastructure Configuration: empty response"))...)
 	}
 
 	d.SetId(aws.StringValue(output.InfrastructureConfigurationArn))
 
-	return resourceAwsImageBuilderInfrastructureConfigurationRead(d, meta)
+	return append(diags, resourceAwsImageBuilderInfrastructureConfigurationRead(ctx, d, meta)...)
 }
 
-func resourceAwsImageBuilderInfrastructureConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsImageBuilderInfrastructureConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).imagebuilderconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -217,7 +290,7 @@ func resourceAwsImageBuilderInfrastructureConfigurationRead(d *schema.ResourceDa
 		InfrastructureConfigurationArn: aws.String(d.Id()),
 	}
 
-	output, err := conn.GetInfrastructureConfiguration(input)
+	output, err := conn.GetInfrastructureConfigurationWithContext(ctx, input)
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Image Builder Infrastructure Configuration (%s) not found, removing from state", d.Id())
@@ -226,11 +299,11 @@ func resourceAwsImageBuilderInfrastructureConfigurationRead(d *schema.ResourceDa
 	}
 
 	if err != nil {
-		return fmt.Errorf("error getting Image Builder Infrastructure Configuration (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error getting Image Builder Infrastructure Configuration (%s): %w", d.Id(), err))
 	}
 
 	if output == nil || output.InfrastructureConfiguration == nil {
-		return fmt.Errorf("error getting Image Builder Infrastructure Configuration (%s): empty response", d.Id())
+		return diag.FromErr(fmt.Errorf("error getting Image Builder Infrastructure Configuration (%s): empty response", d.Id()))
 	}
 
 	infrastructureConfiguration := output.InfrastructureConfiguration
@@ -255,12 +328,26 @@ func resourceAwsImageBuilderInfrastructureConfigurationRead(d *schema.ResourceDa
 	d.Set("tags", keyvaluetags.ImagebuilderKeyValueTags(infrastructureConfiguration.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map())
 	d.Set("terminate_instance_on_failure", infrastructureConfiguration.TerminateInstanceOnFailure)
 
+	if d.Get("resolve_instance_role_arn").(bool) {
+		instanceRoleArn, err := imageBuilderInfrastructureConfigurationInstanceRoleArn(meta.(*AWSClient).iamconn, aws.StringValue(infrastructureConfiguration.InstanceProfileName))
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading Image Builder Infrastructure Configuration (%s) instance role: %w", d.Id(), err))
+		}
+
+		d.Set("instance_role_arn", instanceRoleArn)
+	} else {
+		d.Set("instance_role_arn", nil)
+	}
+
 	return nil
 }
 
-func resourceAwsImageBuilderInfrastructureConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsImageBuilderInfrastructureConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).imagebuilderconn
 
+	var diags diag.Diagnostics
+
 	if d.HasChanges(
 		"description",
 		"instance_profile_name",
@@ -313,12 +400,42 @@ func resourceAwsImageBuilderInfrastructureConfigurationUpdate(d *schema.Resource
 			input.SubnetId = aws.String(v.(string))
 		}
 
-		if v, ok := d.GetOk("terminate_instance_on_failure"); ok {
-			input.TerminateInstanceOnFailure = aws.Bool(v.(bool))
+		input.TerminateInstanceOnFailure = aws.Bool(d.Get("terminate_instance_on_failure").(bool))
+
+		if d.Get("validate_instance_profile").(bool) && d.HasChanges("instance_profile_name", "validate_instance_profile") {
+			if err := imageBuilderInfrastructureConfigurationValidateInstanceProfile(meta.(*AWSClient).iamconn, aws.StringValue(input.InstanceProfileName)); err != nil {
+				return diag.FromErr(fmt.Errorf("error updating Image Builder Infrastructure Configuration (%s): %w", d.Id(), err))
+			}
+		}
+
+		if d.Get("validate_key_pair").(bool) && d.HasChanges("key_pair", "validate_key_pair") {
+			if err := imageBuilderInfrastructureConfigurationValidateKeyPair(meta.(*AWSClient).ec2conn, aws.StringValue(input.KeyPair)); err != nil {
+				return diag.FromErr(fmt.Errorf("error updating Image Builder Infrastructure Configuration (%s): %w", d.Id(), err))
+			}
+		}
+
+		if d.Get("validate_security_group_vpc").(bool) && d.HasChanges("security_group_ids", "subnet_id", "validate_security_group_vpc") {
+			if err := imageBuilderInfrastructureConfigurationValidateSecurityGroupVpc(meta.(*AWSClient).ec2conn, input.SubnetId, input.SecurityGroupIds); err != nil {
+				return diag.FromErr(fmt.Errorf("error updating Image Builder Infrastructure Configuration (%s): %w", d.Id(), err))
+			}
+		}
+
+		if d.Get("validate_sns_topic").(bool) && d.HasChanges("sns_topic_arn", "validate_sns_topic") {
+			snsTopicDiags, err := imageBuilderInfrastructureConfigurationValidateSnsTopic(meta.(*AWSClient).snsconn, meta.(*AWSClient).region, aws.StringValue(input.SnsTopicArn))
+
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error updating Image Builder Infrastructure Configuration (%s): %w", d.Id(), err))
+			}
+
+			diags = append(diags, snsTopicDiags...)
+		}
+
+		if d.Get("check_subnet_egress").(bool) && d.HasChange("subnet_id") && input.SubnetId != nil {
+			diags = append(diags, imageBuilderInfrastructureConfigurationCheckSubnetEgress(meta.(*AWSClient).ec2conn, aws.StringValue(input.SubnetId))...)
 		}
 
 		err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
-			_, err := conn.UpdateInfrastructureConfiguration(input)
+			_, err := conn.UpdateInfrastructureConfigurationWithContext(ctx, input)
 
 			if tfawserr.ErrMessageContains(err, imagebuilder.ErrCodeInvalidParameterValueException, "instance profile does not exist") {
 				return resource.RetryableError(err)
@@ -332,11 +449,11 @@ func resourceAwsImageBuilderInfrastructureConfigurationUpdate(d *schema.Resource
 		})
 
 		if tfresource.TimedOut(err) {
-			_, err = conn.UpdateInfrastructureConfiguration(input)
+			_, err = conn.UpdateInfrastructureConfigurationWithContext(ctx, input)
 		}
 
 		if err != nil {
-			return fmt.Errorf("error updating Image Builder Infrastructure Configuration (%s): %w", d.Id(), err)
+			return append(diags, diag.FromErr(fmt.Errorf("error updating Image Builder Infrastructure Configuration (%s): %w", d.Id(), err))...)
 		}
 	}
 
@@ -344,33 +461,308 @@ func resourceAwsImageBuilderInfrastructureConfigurationUpdate(d *schema.Resource
 		o, n := d.GetChange("tags")
 
 		if err := keyvaluetags.ImagebuilderUpdateTags(conn, d.Id(), o, n); err != nil {
-			return fmt.Errorf("error updating tags for Image Builder Infrastructure Configuration (%s): %w", d.Id(), err)
+			return append(diags, diag.FromErr(fmt.Errorf("error updating tags for Image Builder Infrastructure Configuration (%s): %w", d.Id(), err))...)
 		}
 	}
 
-	return resourceAwsImageBuilderInfrastructureConfigurationRead(d, meta)
+	return append(diags, resourceAwsImageBuilderInfrastructureConfigurationRead(ctx, d, meta)...)
 }
 
-func resourceAwsImageBuilderInfrastructureConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsImageBuilderInfrastructureConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*AWSClient).imagebuilderconn
 
 	input := &imagebuilder.DeleteInfrastructureConfigurationInput{
 		InfrastructureConfigurationArn: aws.String(d.Id()),
 	}
 
-	_, err := conn.DeleteInfrastructureConfiguration(input)
+	_, err := conn.DeleteInfrastructureConfigurationWithContext(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting Image Builder Infrastructure Configuration (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting Image Builder Infrastructure Configuration (%s): %w", d.Id(), err))
 	}
 
 	return nil
 }
 
+// imageBuilderInfrastructureConfigurationInstanceRoleArn returns the ARN of the IAM role in the instance profile.
+// An empty string is returned when the instance profile has no role, does not exist or cannot be read due to missing IAM permissions.
+func imageBuilderInfrastructureConfigurationInstanceRoleArn(conn *iam.IAM, instanceProfileName string) (string, error) {
+	if instanceProfileName == "" {
+		return "", nil
+	}
+
+	instanceProfile, err := iamfinder.InstanceProfileByName(conn, instanceProfileName)
+
+	if tfawserr.ErrCodeContains(err, "AccessDenied") {
+		log.Printf("[WARN] Unable to read IAM Instance Profile (%s) role: %s", instanceProfileName, err)
+		return "", nil
+	}
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) || (err == nil && instanceProfile == nil) {
+		log.Printf("[WARN] IAM Instance Profile (%s) not found", instanceProfileName)
+		return "", nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("error reading IAM Instance Profile (%s): %w", instanceProfileName, err)
+	}
+
+	for _, role := range instanceProfile.Roles {
+		if role == nil {
+			continue
+		}
+
+		return aws.StringValue(role.Arn), nil
+	}
+
+	return "", nil
+}
+
+// imageBuilderInfrastructureConfigurationValidateInstanceProfile verifies that the IAM Instance Profile exists,
+// allowing for IAM eventual consistency when it was just created. Missing IAM permissions are not reported.
+func imageBuilderInfrastructureConfigurationValidateInstanceProfile(conn *iam.IAM, name string) error {
+	if name == "" {
+		return nil
+	}
+
+	var instanceProfile *iam.InstanceProfile
+	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
+		var err error
+
+		instanceProfile, err = iamfinder.InstanceProfileByName(conn, name)
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		instanceProfile, err = iamfinder.InstanceProfileByName(conn, name)
+	}
+
+	if tfawserr.ErrCodeContains(err, "AccessDenied") {
+		log.Printf("[WARN] Unable to verify IAM Instance Profile (%s) exists: %s", name, err)
+		return nil
+	}
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) || (err == nil && instanceProfile == nil) {
+		return fmt.Errorf("IAM Instance Profile (%s) not found", name)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Instance Profile (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+// imageBuilderInfrastructureConfigurationValidateKeyPair verifies that the EC2 Key Pair exists in the current region.
+// It is a no-op unless a key pair is configured.
+func imageBuilderInfrastructureConfigurationValidateKeyPair(conn *ec2.EC2, keyName string) error {
+	if keyName == "" {
+		return nil
+	}
+
+	keyPair, err := finder.KeyPairByName(conn, keyName)
+
+	if tfawserr.ErrCodeEquals(err, tfec2.ErrCodeInvalidKeyPairNotFound) {
+		return fmt.Errorf("EC2 Key Pair (%s) not found", keyName)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Key Pair (%s): %w", keyName, err)
+	}
+
+	if keyPair == nil {
+		return fmt.Errorf("EC2 Key Pair (%s) not found", keyName)
+	}
+
+	return nil
+}
+
+// imageBuilderInfrastructureConfigurationValidateSecurityGroupVpc verifies that all security groups
+// belong to the VPC of the subnet. It is a no-op unless both are configured.
+func imageBuilderInfrastructureConfigurationValidateSecurityGroupVpc(conn *ec2.EC2, subnetID *string, securityGroupIDs []*string) error {
+	if aws.StringValue(subnetID) == "" || len(securityGroupIDs) == 0 {
+		return nil
+	}
+
+	subnet, err := finder.SubnetByID(conn, aws.StringValue(subnetID))
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Subnet (%s): %w", aws.StringValue(subnetID), err)
+	}
+
+	if subnet == nil {
+		return fmt.Errorf("error reading EC2 Subnet (%s): not found", aws.StringValue(subnetID))
+	}
+
+	vpcID := aws.StringValue(subnet.VpcId)
+
+	for _, securityGroupID := range securityGroupIDs {
+		securityGroup, err := finder.SecurityGroupByID(conn, aws.StringValue(securityGroupID))
+
+		if err != nil {
+			return fmt.Errorf("error reading EC2 Security Group (%s): %w", aws.StringValue(securityGroupID), err)
+		}
+
+		if securityGroup == nil {
+			return fmt.Errorf("error reading EC2 Security Group (%s): not found", aws.StringValue(securityGroupID))
+		}
+
+		if v := aws.StringValue(securityGroup.VpcId); v != vpcID {
+			return fmt.Errorf("EC2 Security Group (%s) belongs to VPC (%s), expected VPC (%s) of EC2 Subnet (%s)", aws.StringValue(securityGroupID), v, vpcID, aws.StringValue(subnetID))
+		}
+	}
+
+	return nil
+}
+
+// imageBuilderInfrastructureConfigurationValidateSnsTopic verifies that the SNS topic exists in the current region.
+// A warning is returned when the topic has no confirmed subscriptions. It is a no-op unless a topic is configured.
+func imageBuilderInfrastructureConfigurationValidateSnsTopic(conn *sns.SNS, region string, topicArn string) (diag.Diagnostics, error) {
+	if topicArn == "" {
+		return nil, nil
+	}
+
+	parsedARN, err := arn.Parse(topicArn)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing SNS Topic ARN (%s): %w", topicArn, err)
+	}
+
+	if actual, expected := parsedARN.Region, region; actual != expected {
+		return nil, fmt.Errorf("SNS Topic (%s) is in region (%s), expected region (%s)", topicArn, actual, expected)
+	}
+
+	output, err := conn.GetTopicAttributes(&sns.GetTopicAttributesInput{
+		TopicArn: aws.String(topicArn),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sns.ErrCodeNotFoundException) {
+		return nil, fmt.Errorf("SNS Topic (%s) not found", topicArn)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading SNS Topic (%s) attributes: %w", topicArn, err)
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	warning := imageBuilderSnsTopicSubscriptionWarning(output.Attributes)
+
+	if warning == "" {
+		return nil, nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Image Builder Infrastructure Configuration SNS topic has no confirmed subscriptions",
+			Detail:   fmt.Sprintf("SNS Topic (%s): %s.", topicArn, warning),
+		},
+	}, nil
+}
+
+// imageBuilderSnsTopicSubscriptionWarning returns a description of why notifications sent to a topic with
+// the given attributes are not delivered, or an empty string when the topic has confirmed subscriptions.
+func imageBuilderSnsTopicSubscriptionWarning(attributes map[string]*string) string {
+	if v, ok := attributes["SubscriptionsConfirmed"]; ok && aws.StringValue(v) != "0" {
+		return ""
+	}
+
+	if v := aws.StringValue(attributes["SubscriptionsPending"]); v != "" && v != "0" {
+		return "topic has no confirmed subscriptions, build notifications are not delivered until a pending subscription is confirmed"
+	}
+
+	return "topic has no subscriptions, build notifications are not delivered"
+}
+
+// imageBuilderInfrastructureConfigurationCheckSubnetEgress returns a warning when build instances launched
+// into the subnet are unlikely to reach the internet. The check is best-effort and lookup errors are only logged.
+func imageBuilderInfrastructureConfigurationCheckSubnetEgress(conn *ec2.EC2, subnetID string) diag.Diagnostics {
+	subnet, err := finder.SubnetByID(conn, subnetID)
+
+	if err != nil || subnet == nil {
+		log.Printf("[WARN] Unable to check egress of EC2 Subnet (%s): %v", subnetID, err)
+		return nil
+	}
+
+	routeTable, err := finder.RouteTableBySubnetID(conn, subnetID)
+
+	if err == nil && routeTable == nil {
+		routeTable, err = finder.MainRouteTableByVpcID(conn, aws.StringValue(subnet.VpcId))
+	}
+
+	if err != nil || routeTable == nil {
+		log.Printf("[WARN] Unable to check egress of EC2 Subnet (%s) route table: %v", subnetID, err)
+		return nil
+	}
+
+	warning := imageBuilderSubnetEgressWarning(subnet, routeTable)
+
+	if warning == "" {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Image Builder Infrastructure Configuration subnet may have no internet egress",
+			Detail:   fmt.Sprintf("EC2 Subnet (%s): %s.", subnetID, warning),
+		},
+	}
+}
+
+// imageBuilderSubnetEgressWarning returns a description of why instances launched into the subnet are
+// unlikely to reach the internet through its route table, or an empty string when a default route exists.
+// IPv6 default routes only count when the subnet assigns IPv6 addresses on creation.
+func imageBuilderSubnetEgressWarning(subnet *ec2.Subnet, routeTable *ec2.RouteTable) string {
+	for _, route := range routeTable.Routes {
+		if route == nil || aws.StringValue(route.State) == ec2.RouteStateBlackhole {
+			continue
+		}
+
+		if aws.StringValue(route.DestinationIpv6CidrBlock) == "::/0" {
+			if aws.BoolValue(subnet.AssignIpv6AddressOnCreation) && (route.EgressOnlyInternetGatewayId != nil || route.GatewayId != nil || route.NetworkInterfaceId != nil || route.TransitGatewayId != nil) {
+				return ""
+			}
+
+			continue
+		}
+
+		if aws.StringValue(route.DestinationCidrBlock) != "0.0.0.0/0" {
+			continue
+		}
+
+		if strings.HasPrefix(aws.StringValue(route.GatewayId), "igw-") {
+			if !aws.BoolValue(subnet.MapPublicIpOnLaunch) {
+				return "default route targets an internet gateway but the subnet does not assign public IP addresses on launch, build instances may be unable to reach the internet"
+			}
+
+			return ""
+		}
+
+		if route.NatGatewayId != nil || route.TransitGatewayId != nil || route.NetworkInterfaceId != nil || route.InstanceId != nil || route.VpcPeeringConnectionId != nil || route.GatewayId != nil {
+			return ""
+		}
+	}
+
+	return "route table has no default route to an internet gateway, NAT gateway or other egress target, build instances may be unable to reach the internet"
+}
+
 func expandImageBuilderLogging(tfMap map[string]interface{}) *imagebuilder.Logging {
 	if tfMap == nil {
 		return nil
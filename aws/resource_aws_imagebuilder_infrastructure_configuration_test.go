@@ -1,11 +1,14 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
@@ -48,10 +51,10 @@ func testSweepImageBuilderInfrastructureConfigurations(region string) error {
 			d := r.Data(nil)
 			d.SetId(arn)
 
-			err := r.Delete(d, client)
+			diags := r.DeleteContext(context.Background(), d, client)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Image Builder Infrastructure Configuration (%s): %w", arn, err)
+			if diags.HasError() {
+				sweeperErr := fmt.Errorf("error deleting Image Builder Infrastructure Configuration (%s): %s", arn, diags[0].Summary)
 				log.Printf("[ERROR] %s", sweeperErr)
 				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
 				continue
@@ -73,6 +76,177 @@ func testSweepImageBuilderInfrastructureConfigurations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func TestImageBuilderSubnetEgressWarning(t *testing.T) {
+	testCases := []struct {
+		TestName        string
+		Subnet          *ec2.Subnet
+		Routes          []*ec2.Route
+		ExpectedWarning *regexp.Regexp
+	}{
+		{
+			TestName: "no routes",
+			Subnet:   &ec2.Subnet{},
+			Routes: []*ec2.Route{
+				{
+					DestinationCidrBlock: aws.String("10.0.0.0/16"),
+					GatewayId:            aws.String("local"),
+				},
+			},
+			ExpectedWarning: regexp.MustCompile(`no default route`),
+		},
+		{
+			TestName: "internet gateway with public IP",
+			Subnet:   &ec2.Subnet{MapPublicIpOnLaunch: aws.Bool(true)},
+			Routes: []*ec2.Route{
+				{
+					DestinationCidrBlock: aws.String("0.0.0.0/0"),
+					GatewayId:            aws.String("igw-12345678"),
+				},
+			},
+		},
+		{
+			TestName: "internet gateway without public IP",
+			Subnet:   &ec2.Subnet{MapPublicIpOnLaunch: aws.Bool(false)},
+			Routes: []*ec2.Route{
+				{
+					DestinationCidrBlock: aws.String("0.0.0.0/0"),
+					GatewayId:            aws.String("igw-12345678"),
+				},
+			},
+			ExpectedWarning: regexp.MustCompile(`does not assign public IP addresses`),
+		},
+		{
+			TestName: "NAT gateway",
+			Subnet:   &ec2.Subnet{},
+			Routes: []*ec2.Route{
+				{
+					DestinationCidrBlock: aws.String("0.0.0.0/0"),
+					NatGatewayId:         aws.String("nat-12345678"),
+				},
+			},
+		},
+		{
+			TestName: "blackhole NAT gateway",
+			Subnet:   &ec2.Subnet{},
+			Routes: []*ec2.Route{
+				{
+					DestinationCidrBlock: aws.String("0.0.0.0/0"),
+					NatGatewayId:         aws.String("nat-12345678"),
+					State:                aws.String(ec2.RouteStateBlackhole),
+				},
+			},
+			ExpectedWarning: regexp.MustCompile(`no default route`),
+		},
+		{
+			TestName: "transit gateway",
+			Subnet:   &ec2.Subnet{},
+			Routes: []*ec2.Route{
+				{
+					DestinationCidrBlock: aws.String("0.0.0.0/0"),
+					TransitGatewayId:     aws.String("tgw-12345678"),
+				},
+			},
+		},
+		{
+			TestName: "egress-only internet gateway with IPv6",
+			Subnet:   &ec2.Subnet{AssignIpv6AddressOnCreation: aws.Bool(true)},
+			Routes: []*ec2.Route{
+				{
+					DestinationIpv6CidrBlock:    aws.String("::/0"),
+					EgressOnlyInternetGatewayId: aws.String("eigw-12345678"),
+				},
+			},
+		},
+		{
+			TestName: "egress-only internet gateway without IPv6",
+			Subnet:   &ec2.Subnet{AssignIpv6AddressOnCreation: aws.Bool(false)},
+			Routes: []*ec2.Route{
+				{
+					DestinationIpv6CidrBlock:    aws.String("::/0"),
+					EgressOnlyInternetGatewayId: aws.String("eigw-12345678"),
+				},
+			},
+			ExpectedWarning: regexp.MustCompile(`no default route`),
+		},
+		{
+			TestName: "blackhole IPv6 internet gateway",
+			Subnet:   &ec2.Subnet{AssignIpv6AddressOnCreation: aws.Bool(true)},
+			Routes: []*ec2.Route{
+				{
+					DestinationIpv6CidrBlock: aws.String("::/0"),
+					GatewayId:                aws.String("igw-12345678"),
+					State:                    aws.String(ec2.RouteStateBlackhole),
+				},
+			},
+			ExpectedWarning: regexp.MustCompile(`no default route`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := imageBuilderSubnetEgressWarning(testCase.Subnet, &ec2.RouteTable{Routes: testCase.Routes})
+
+			if got != "" && testCase.ExpectedWarning == nil {
+				t.Fatalf("got unexpected warning: %s", got)
+			}
+
+			if testCase.ExpectedWarning != nil && !testCase.ExpectedWarning.MatchString(got) {
+				t.Fatalf("expected warning %s, got: %s", testCase.ExpectedWarning.String(), got)
+			}
+		})
+	}
+}
+
+func TestImageBuilderSnsTopicSubscriptionWarning(t *testing.T) {
+	testCases := []struct {
+		TestName        string
+		Attributes      map[string]*string
+		ExpectedWarning *regexp.Regexp
+	}{
+		{
+			TestName:        "no attributes",
+			ExpectedWarning: regexp.MustCompile(`no subscriptions`),
+		},
+		{
+			TestName: "no subscriptions",
+			Attributes: map[string]*string{
+				"SubscriptionsConfirmed": aws.String("0"),
+				"SubscriptionsPending":   aws.String("0"),
+			},
+			ExpectedWarning: regexp.MustCompile(`no subscriptions`),
+		},
+		{
+			TestName: "pending subscriptions",
+			Attributes: map[string]*string{
+				"SubscriptionsConfirmed": aws.String("0"),
+				"SubscriptionsPending":   aws.String("1"),
+			},
+			ExpectedWarning: regexp.MustCompile(`no confirmed subscriptions`),
+		},
+		{
+			TestName: "confirmed subscriptions",
+			Attributes: map[string]*string{
+				"SubscriptionsConfirmed": aws.String("2"),
+				"SubscriptionsPending":   aws.String("0"),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := imageBuilderSnsTopicSubscriptionWarning(testCase.Attributes)
+
+			if got != "" && testCase.ExpectedWarning == nil {
+				t.Fatalf("got unexpected warning: %s", got)
+			}
+
+			if testCase.ExpectedWarning != nil && !testCase.ExpectedWarning.MatchString(got) {
+				t.Fatalf("expected warning %s, got: %s", testCase.ExpectedWarning.String(), got)
+			}
+		})
+	}
+}
+
 func TestAccAwsImageBuilderInfrastructureConfiguration_basic(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	iamInstanceProfileResourceName := "aws_iam_instance_profile.test"
@@ -102,6 +276,7 @@ func TestAccAwsImageBuilderInfrastructureConfiguration_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "subnet_id", ""),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttr(resourceName, "terminate_instance_on_failure", "false"),
+					testAccCheckAwsImageBuilderInfrastructureConfigurationTerminateInstanceOnFailure(resourceName, false),
 				),
 			},
 			{
@@ -346,6 +521,46 @@ func TestAccAwsImageBuilderInfrastructureConfiguration_Logging_S3Logs_S3KeyPrefi
 	})
 }
 
+func TestAccAwsImageBuilderInfrastructureConfiguration_Name(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	name1 := fmt.Sprintf("%s-1", rName)
+	name2 := fmt.Sprintf("%s-2", rName)
+	resourceName := "aws_imagebuilder_infrastructure_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsImageBuilderInfrastructureConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsImageBuilderInfrastructureConfigurationConfigNameValue(rName, "invalid name!"),
+				ExpectError: regexp.MustCompile(`valid name must be provided`),
+			},
+			{
+				Config: testAccAwsImageBuilderInfrastructureConfigurationConfigNameValue(rName, name1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "imagebuilder", fmt.Sprintf("infrastructure-configuration/%s", name1)),
+					resource.TestCheckResourceAttr(resourceName, "name", name1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsImageBuilderInfrastructureConfigurationConfigNameValue(rName, name2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "imagebuilder", fmt.Sprintf("infrastructure-configuration/%s", name2)),
+					resource.TestCheckResourceAttr(resourceName, "name", name2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAwsImageBuilderInfrastructureConfiguration_ResourceTags(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_imagebuilder_infrastructure_configuration.test"
@@ -381,6 +596,42 @@ func TestAccAwsImageBuilderInfrastructureConfiguration_ResourceTags(t *testing.T
 	})
 }
 
+func TestAccAwsImageBuilderInfrastructureConfiguration_ResolveInstanceRoleArn(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	iamRoleResourceName := "aws_iam_role.role"
+	resourceName := "aws_imagebuilder_infrastructure_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsImageBuilderInfrastructureConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderInfrastructureConfigurationConfigResolveInstanceRoleArn(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_role_arn", iamRoleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "resolve_instance_role_arn", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"instance_role_arn", "resolve_instance_role_arn"},
+			},
+			{
+				Config: testAccAwsImageBuilderInfrastructureConfigurationConfigResolveInstanceRoleArn(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "instance_role_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "resolve_instance_role_arn", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAwsImageBuilderInfrastructureConfiguration_SecurityGroupIds(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	securityGroupResourceName := "aws_security_group.test"
@@ -488,6 +739,35 @@ func TestAccAwsImageBuilderInfrastructureConfiguration_SubnetId(t *testing.T) {
 	})
 }
 
+func TestAccAwsImageBuilderInfrastructureConfiguration_CheckSubnetEgress(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	subnetResourceName := "aws_subnet.test"
+	resourceName := "aws_imagebuilder_infrastructure_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsImageBuilderInfrastructureConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The subnet has no route to an egress target, so creation succeeds with a warning.
+				Config: testAccAwsImageBuilderInfrastructureConfigurationConfigCheckSubnetEgress(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "check_subnet_egress", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_id", subnetResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"check_subnet_egress"},
+			},
+		},
+	})
+}
+
 func TestAccAwsImageBuilderInfrastructureConfiguration_Tags(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_imagebuilder_infrastructure_configuration.test"
@@ -545,6 +825,7 @@ func TestAccAwsImageBuilderInfrastructureConfiguration_TerminateInstanceOnFailur
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "terminate_instance_on_failure", "true"),
+					testAccCheckAwsImageBuilderInfrastructureConfigurationTerminateInstanceOnFailure(resourceName, true),
 				),
 			},
 			{
@@ -558,6 +839,168 @@ func TestAccAwsImageBuilderInfrastructureConfiguration_TerminateInstanceOnFailur
 					testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName),
 					testAccCheckResourceAttrRfc3339(resourceName, "date_updated"),
 					resource.TestCheckResourceAttr(resourceName, "terminate_instance_on_failure", "false"),
+					testAccCheckAwsImageBuilderInfrastructureConfigurationTerminateInstanceOnFailure(resourceName, false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderInfrastructureConfiguration_ValidateInstanceProfile(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	iamInstanceProfileResourceName := "aws_iam_instance_profile.test"
+	resourceName := "aws_imagebuilder_infrastructure_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsImageBuilderInfrastructureConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsImageBuilderInfrastructureConfigurationConfigValidateInstanceProfile(rName, rName+"-absent"),
+				ExpectError: regexp.MustCompile(`IAM Instance Profile \(.+-absent\) not found`),
+			},
+			{
+				Config: testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName),
+			},
+			{
+				Config: testAccAwsImageBuilderInfrastructureConfigurationConfigValidateInstanceProfile(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_profile_name", iamInstanceProfileResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "validate_instance_profile", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validate_instance_profile"},
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderInfrastructureConfiguration_ValidateInstanceProfile_SameConfiguration(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	iamInstanceProfileResourceName := "aws_iam_instance_profile.test"
+	resourceName := "aws_imagebuilder_infrastructure_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsImageBuilderInfrastructureConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderInfrastructureConfigurationConfigValidateInstanceProfileSameConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_profile_name", iamInstanceProfileResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "validate_instance_profile", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderInfrastructureConfiguration_ValidateKeyPair(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	keyPairResourceName := "aws_key_pair.test"
+	resourceName := "aws_imagebuilder_infrastructure_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsImageBuilderInfrastructureConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsImageBuilderInfrastructureConfigurationConfigValidateKeyPair(rName, `"${aws_key_pair.test.key_name}-absent"`),
+				ExpectError: regexp.MustCompile(`EC2 Key Pair \(.+-absent\) not found`),
+			},
+			{
+				Config: testAccAwsImageBuilderInfrastructureConfigurationConfigValidateKeyPair(rName, "aws_key_pair.test.key_name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "key_pair", keyPairResourceName, "key_name"),
+					resource.TestCheckResourceAttr(resourceName, "validate_key_pair", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validate_key_pair"},
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderInfrastructureConfiguration_ValidateSecurityGroupVpc(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	securityGroupResourceName := "aws_security_group.test"
+	resourceName := "aws_imagebuilder_infrastructure_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsImageBuilderInfrastructureConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsImageBuilderInfrastructureConfigurationConfigValidateSecurityGroupVpc(rName, "aws_security_group.other.id"),
+				ExpectError: regexp.MustCompile(`belongs to VPC \(vpc-[a-z0-9]+\), expected VPC`),
+			},
+			{
+				Config: testAccAwsImageBuilderInfrastructureConfigurationConfigValidateSecurityGroupVpc(rName, "aws_security_group.test.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_group_ids.*", securityGroupResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "validate_security_group_vpc", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validate_security_group_vpc"},
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderInfrastructureConfiguration_ValidateSnsTopic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	topicResourceName := "aws_sns_topic.test"
+	resourceName := "aws_imagebuilder_infrastructure_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsImageBuilderInfrastructureConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsImageBuilderInfrastructureConfigurationConfigValidateSnsTopic(rName, `"arn:${data.aws_partition.current.partition}:sns:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:${aws_sns_topic.test.name}-absent"`),
+				ExpectError: regexp.MustCompile(`SNS Topic \(.+-absent\) not found`),
+			},
+			{
+				Config: testAccAwsImageBuilderInfrastructureConfigurationConfigValidateSnsTopic(rName, "aws_sns_topic.test.arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "sns_topic_arn", topicResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "validate_sns_topic", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validate_sns_topic"},
+			},
+			{
+				Config: testAccAwsImageBuilderInfrastructureConfigurationConfigValidateSnsTopicSubscription(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "sns_topic_arn", "aws_sns_topic.subscribed", "arn"),
 				),
 			},
 		},
@@ -617,6 +1060,37 @@ func testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName s
 	}
 }
 
+func testAccCheckAwsImageBuilderInfrastructureConfigurationTerminateInstanceOnFailure(resourceName string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+
+		input := &imagebuilder.GetInfrastructureConfigurationInput{
+			InfrastructureConfigurationArn: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.GetInfrastructureConfiguration(input)
+
+		if err != nil {
+			return fmt.Errorf("error getting Image Builder Infrastructure Configuration (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output == nil || output.InfrastructureConfiguration == nil {
+			return fmt.Errorf("error getting Image Builder Infrastructure Configuration (%s): empty response", rs.Primary.ID)
+		}
+
+		if got := aws.BoolValue(output.InfrastructureConfiguration.TerminateInstanceOnFailure); got != expected {
+			return fmt.Errorf("Image Builder Infrastructure Configuration (%s) terminate instance on failure: got %t, expected %t", rs.Primary.ID, got, expected)
+		}
+
+		return nil
+	}
+}
+
 func testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -832,6 +1306,17 @@ resource "aws_imagebuilder_infrastructure_configuration" "test" {
 `, rName))
 }
 
+func testAccAwsImageBuilderInfrastructureConfigurationConfigNameValue(rName string, name string) string {
+	return composeConfig(
+		testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_imagebuilder_infrastructure_configuration" "test" {
+  instance_profile_name = aws_iam_instance_profile.test.name
+  name                  = %[1]q
+}
+`, name))
+}
+
 func testAccAwsImageBuilderInfrastructureConfigurationConfigResourceTags(rName string, resourceTagKey string, resourceTagValue string) string {
 	return composeConfig(
 		testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName),
@@ -847,6 +1332,18 @@ resource "aws_imagebuilder_infrastructure_configuration" "test" {
 `, rName, resourceTagKey, resourceTagValue))
 }
 
+func testAccAwsImageBuilderInfrastructureConfigurationConfigResolveInstanceRoleArn(rName string, resolveInstanceRoleArn bool) string {
+	return composeConfig(
+		testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_imagebuilder_infrastructure_configuration" "test" {
+  instance_profile_name     = aws_iam_instance_profile.test.name
+  name                      = %[1]q
+  resolve_instance_role_arn = %[2]t
+}
+`, rName, resolveInstanceRoleArn))
+}
+
 func testAccAwsImageBuilderInfrastructureConfigurationConfigSecurityGroupIds1(rName string) string {
 	return composeConfig(
 		testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName),
@@ -887,6 +1384,33 @@ resource "aws_imagebuilder_infrastructure_configuration" "test" {
 `, rName))
 }
 
+func testAccAwsImageBuilderInfrastructureConfigurationConfigCheckSubnetEgress(rName string) string {
+	return composeConfig(
+		testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = aws_vpc.test.id
+}
+
+resource "aws_subnet" "test" {
+  cidr_block = cidrsubnet(aws_vpc.test.cidr_block, 2, 0)
+  vpc_id     = aws_vpc.test.id
+}
+
+resource "aws_imagebuilder_infrastructure_configuration" "test" {
+  check_subnet_egress   = true
+  instance_profile_name = aws_iam_instance_profile.test.name
+  name                  = %[1]q
+  security_group_ids    = [aws_security_group.test.id] # Required with subnet_id
+  subnet_id             = aws_subnet.test.id
+}
+`, rName))
+}
+
 func testAccAwsImageBuilderInfrastructureConfigurationConfigSubnetId1(rName string) string {
 	return composeConfig(
 		testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName),
@@ -939,6 +1463,133 @@ resource "aws_imagebuilder_infrastructure_configuration" "test" {
 `, rName))
 }
 
+func testAccAwsImageBuilderInfrastructureConfigurationConfigValidateInstanceProfile(rName string, instanceProfileName string) string {
+	return composeConfig(
+		testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_imagebuilder_infrastructure_configuration" "test" {
+  instance_profile_name     = %[2]q
+  name                      = %[1]q
+  validate_instance_profile = true
+}
+`, rName, instanceProfileName))
+}
+
+func testAccAwsImageBuilderInfrastructureConfigurationConfigValidateInstanceProfileSameConfiguration(rName string) string {
+	return composeConfig(
+		testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_imagebuilder_infrastructure_configuration" "test" {
+  instance_profile_name     = aws_iam_instance_profile.test.name
+  name                      = %[1]q
+  validate_instance_profile = true
+}
+`, rName))
+}
+
+func testAccAwsImageBuilderInfrastructureConfigurationConfigValidateKeyPair(rName string, keyPair string) string {
+	return composeConfig(
+		testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_key_pair" "test" {
+  key_name   = %[1]q
+  public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQD3F6tyPEFEzV0LX3X8BsXdMsQz1x2cEikKDEY0aIj41qgxMCP/iteneqXSIFZBp5vizPvaoIR3Um9xK7PGoW8giupGn+EPuxIA4cDM4vzOqOkiMPhz5XK0whEjkVzTo4+S0puvDZuwIsdiW9mxhJc7tgBNL0cYlWSYVkz4G/fslNfRPW5mYAM49f4fhtxPb5ok4Q2Lg9dPKVHO/Bgeu5woMc7RY0p1ej6D4CKFE6lymSDJpW0YHX/wqE9+cfEauh7xZcG0q9t2ta6F6fmX0agvpFyZo8aFbXeUBr7osSCJNgvavWbM/06niWrOvYX2xwWdhXmXSrbX8ZbabVohBK41 example@example.com"
+}
+
+resource "aws_imagebuilder_infrastructure_configuration" "test" {
+  instance_profile_name = aws_iam_instance_profile.test.name
+  key_pair              = %[2]s
+  name                  = %[1]q
+  validate_key_pair     = true
+}
+`, rName, keyPair))
+}
+
+func testAccAwsImageBuilderInfrastructureConfigurationConfigValidateSecurityGroupVpc(rName string, securityGroupID string) string {
+	return composeConfig(
+		testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_vpc" "other" {
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = aws_vpc.test.id
+}
+
+resource "aws_security_group" "other" {
+  vpc_id = aws_vpc.other.id
+}
+
+resource "aws_subnet" "test" {
+  cidr_block = cidrsubnet(aws_vpc.test.cidr_block, 2, 0)
+  vpc_id     = aws_vpc.test.id
+}
+
+resource "aws_imagebuilder_infrastructure_configuration" "test" {
+  instance_profile_name       = aws_iam_instance_profile.test.name
+  name                        = %[1]q
+  security_group_ids          = [%[2]s]
+  subnet_id                   = aws_subnet.test.id
+  validate_security_group_vpc = true
+}
+`, rName, securityGroupID))
+}
+
+func testAccAwsImageBuilderInfrastructureConfigurationConfigValidateSnsTopic(rName string, topicArn string) string {
+	return composeConfig(
+		testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName),
+		fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_imagebuilder_infrastructure_configuration" "test" {
+  instance_profile_name = aws_iam_instance_profile.test.name
+  name                  = %[1]q
+  sns_topic_arn         = %[2]s
+  validate_sns_topic    = true
+}
+`, rName, topicArn))
+}
+
+func testAccAwsImageBuilderInfrastructureConfigurationConfigValidateSnsTopicSubscription(rName string) string {
+	return composeConfig(
+		testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_sns_topic" "subscribed" {
+  name = "%[1]s-subscribed"
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_sns_topic_subscription" "test" {
+  endpoint  = aws_sqs_queue.test.arn
+  protocol  = "sqs"
+  topic_arn = aws_sns_topic.subscribed.arn
+}
+
+resource "aws_imagebuilder_infrastructure_configuration" "test" {
+  instance_profile_name = aws_iam_instance_profile.test.name
+  name                  = %[1]q
+  sns_topic_arn         = aws_sns_topic.subscribed.arn
+  validate_sns_topic    = true
+
+  depends_on = [aws_sns_topic_subscription.test]
+}
+`, rName))
+}
+
 func testAccAwsImageBuilderInfrastructureConfigurationConfigSnsTopicArn1(rName string) string {
 	return composeConfig(
 		testAccAwsImageBuilderInfrastructureConfigurationConfigBase(rName),
@@ -27,6 +27,9 @@ func TestAccAWSOrganizations_serial(t *testing.T) {
 		"OrganizationalUnits": {
 			"DataSource": testAccDataSourceAwsOrganizationsOrganizationalUnits_basic,
 		},
+		"ImageBuilderOuMemberAccounts": {
+			"DataSource": testAccAwsImageBuilderOuMemberAccountsDataSource_ParentId,
+		},
 		"Policy": {
 			"basic":                  testAccAwsOrganizationsPolicy_basic,
 			"concurrent":             testAccAwsOrganizationsPolicy_concurrent,
@@ -2427,6 +2427,32 @@ func validateRoute53ResolverName(v interface{}, k string) (ws []string, errors [
 	return
 }
 
+// validateImageBuilderAmiNameTemplateLength warns when the static portion of an Image Builder
+// AMI name template leaves little room for its expanded variables, such as {{ imagebuilder:buildDate }},
+// since the AMI name length limit applies after expansion.
+func validateImageBuilderAmiNameTemplateLength(v interface{}, k string) (ws []string, errors []error) {
+	// Length of an expanded {{ imagebuilder:buildDate }}, e.g. 2021-02-03T04-05-06.789Z.
+	const expansionLength = 24
+	const maxLength = 127
+
+	value := v.(string)
+	templateRegexp := regexp.MustCompile(`\{\{[^}]*\}\}`)
+	templates := templateRegexp.FindAllString(value, -1)
+
+	if len(templates) == 0 {
+		return
+	}
+
+	staticLength := len(templateRegexp.ReplaceAllString(value, ""))
+
+	if staticLength+len(templates)*expansionLength > maxLength {
+		ws = append(ws, fmt.Sprintf(
+			"%q has %d characters outside of template variables, which may exceed the %d character AMI name limit once its %d template variable(s) are expanded", k, staticLength, maxLength, len(templates)))
+	}
+
+	return
+}
+
 var validateCloudWatchEventCustomEventBusName = validation.All(
 	validation.StringLenBetween(1, 256),
 	validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9._\-]+$`), ""),
@@ -3308,3 +3308,32 @@ func TestValidateUTCTimestamp(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateImageBuilderAmiNameTemplateLength(t *testing.T) {
+	validNames := []string{
+		"test",
+		"test-{{ imagebuilder:buildDate }}",
+		strings.Repeat("x", 127),
+		strings.Repeat("x", 103) + "{{ imagebuilder:buildDate }}",
+	}
+	for _, v := range validNames {
+		ws, errors := validateImageBuilderAmiNameTemplateLength(v, "name")
+		if len(errors) != 0 || len(ws) != 0 {
+			t.Fatalf("%q should not produce warnings, got: %v", v, ws)
+		}
+	}
+
+	warningNames := []string{
+		strings.Repeat("x", 104) + "{{ imagebuilder:buildDate }}",
+		strings.Repeat("x", 80) + "{{ imagebuilder:buildDate }}-{{ imagebuilder:buildDate }}",
+	}
+	for _, v := range warningNames {
+		ws, errors := validateImageBuilderAmiNameTemplateLength(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should not produce errors, got: %v", v, errors)
+		}
+		if len(ws) != 1 {
+			t.Fatalf("%q should produce a warning", v)
+		}
+	}
+}